@@ -0,0 +1,96 @@
+package glinq
+
+import "context"
+
+// FromChannel creates a Stream that receives elements from a channel.
+// Next blocks until a value is received and the Stream ends when the channel is closed.
+//
+// NOTE: A channel can be drained only once. Every terminal operation continues receiving
+// from the same channel, so a second iteration sees only the values left in it.
+//
+// SIZE: Unknown (the number of values sent is not known in advance).
+//
+// Example:
+//
+//	jobs := make(chan Job)
+//	go produce(jobs) // closes jobs when done
+//	urgent := FromChannel(jobs).Where(isUrgent).ToSlice()
+func FromChannel[T any](ch <-chan T) Stream[T] {
+	return &stream[T]{
+		sourceFactory: func(sc *scope) func() (T, bool) {
+			return func() (T, bool) {
+				value, ok := <-ch
+				return value, ok
+			}
+		},
+		size:    -1, // UNKNOWN: channel length is not known in advance
+		oneShot: true,
+	}
+}
+
+// FromChannelContext creates a Stream that receives elements from a channel until
+// the channel is closed or ctx is cancelled, whichever happens first.
+//
+// SIZE: Unknown (the number of values sent is not known in advance).
+//
+// Example:
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+//	defer cancel()
+//	batch := FromChannelContext(ctx, events).Take(100).ToSlice()
+func FromChannelContext[T any](ctx context.Context, ch <-chan T) Stream[T] {
+	return &stream[T]{
+		sourceFactory: func(sc *scope) func() (T, bool) {
+			return func() (T, bool) {
+				var zero T
+				select {
+				case <-ctx.Done():
+					return zero, false
+				case value, ok := <-ch:
+					if !ok {
+						return zero, false
+					}
+					return value, true
+				}
+			}
+		},
+		size:    -1, // UNKNOWN: channel length is not known in advance
+		oneShot: true,
+	}
+}
+
+// ToChannel runs the Stream in a new goroutine and sends every element to the returned channel.
+// The channel is closed when the Stream is exhausted or ctx is cancelled.
+// buffer is the channel capacity; a full buffer blocks the producer (back-pressure).
+// Negative buffer values are treated as 0 (unbuffered).
+//
+// NOTE: The producer goroutine exits only when the Stream is exhausted or ctx is cancelled.
+// Cancel ctx if you stop receiving before the channel is closed.
+func (s *stream[T]) ToChannel(ctx context.Context, buffer int) <-chan T {
+	if buffer < 0 {
+		buffer = 0
+	}
+	out := make(chan T, buffer)
+
+	go func() {
+		defer close(out)
+		iterator, sc := s.open() // Fresh iterator
+		defer sc.close()
+		for {
+			if ctx.Err() != nil {
+				return
+			}
+			value, ok := iterator()
+			if !ok {
+				return
+			}
+			select {
+			case out <- value:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out
+}
@@ -0,0 +1,133 @@
+package glinq
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestFromChannel(t *testing.T) {
+	ch := make(chan int)
+	go func() {
+		defer close(ch)
+		for i := 1; i <= 5; i++ {
+			ch <- i
+		}
+	}()
+
+	result := FromChannel(ch).Where(func(x int) bool { return x%2 == 1 }).ToSlice()
+
+	expected := []int{1, 3, 5}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+	assertNoSize(t, FromChannel(ch), "FromChannel")
+}
+
+func TestFromChannelTakeLeavesRest(t *testing.T) {
+	ch := make(chan int, 5)
+	for i := 1; i <= 5; i++ {
+		ch <- i
+	}
+	close(ch)
+
+	s := FromChannel(ch)
+	first := s.Take(2).ToSlice()
+	rest := s.ToSlice()
+
+	if !reflect.DeepEqual(first, []int{1, 2}) || !reflect.DeepEqual(rest, []int{3, 4, 5}) {
+		t.Errorf("Unexpected results: %v, %v", first, rest)
+	}
+}
+
+func TestFromChannelTakeCount(t *testing.T) {
+	ch := make(chan int, 3)
+	for i := 1; i <= 3; i++ {
+		ch <- i
+	}
+	close(ch)
+
+	taken := FromChannel(ch).Take(10)
+	assertNoSize(t, taken, "FromChannel.Take")
+	if n := taken.Count(); n != 3 {
+		t.Errorf("Expected 3 elements, got %d", n)
+	}
+	if FromChannel(ch).Take(10).Any() {
+		t.Error("Expected a drained channel to be empty")
+	}
+}
+
+func TestFromChannelContext(t *testing.T) {
+	ch := make(chan int) // Never closed
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		ch <- 1
+		ch <- 2
+		cancel()
+	}()
+
+	result := FromChannelContext(ctx, ch).ToSlice()
+
+	expected := []int{1, 2}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+}
+
+func TestToChannel(t *testing.T) {
+	t.Run("Sends all elements and closes", func(t *testing.T) {
+		var result []int
+		for x := range Range(1, 5).Select(func(x int) int { return x * x }).ToChannel(context.Background(), 2) {
+			result = append(result, x)
+		}
+
+		expected := []int{1, 4, 9, 16, 25}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("Expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("Cancellation stops producer", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		pulled := 0
+		source := Range(0, 1_000_000).Select(func(x int) int {
+			pulled++
+			return x
+		})
+
+		ch := source.ToChannel(ctx, 0)
+		<-ch
+		<-ch
+		cancel()
+
+		select {
+		case <-drain(ch):
+		case <-time.After(time.Second):
+			t.Fatal("Expected channel to be closed after cancellation")
+		}
+		if pulled > 4 {
+			t.Errorf("Expected producer to stop after cancellation, pulled %d", pulled)
+		}
+	})
+
+	t.Run("Round trip through FromChannel", func(t *testing.T) {
+		result := FromChannel(From([]string{"a", "b"}).ToChannel(context.Background(), 0)).ToSlice()
+
+		expected := []string{"a", "b"}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("Expected %v, got %v", expected, result)
+		}
+	})
+}
+
+// drain receives from ch until it is closed and then closes the returned channel
+func drain[T any](ch <-chan T) <-chan struct{} {
+	done := make(chan struct{})
+	go func() {
+		for range ch {
+		}
+		close(done)
+	}()
+	return done
+}
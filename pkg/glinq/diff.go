@@ -0,0 +1,382 @@
+package glinq
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// ChangeKind tells how an element differs between two snapshots.
+type ChangeKind int
+
+const (
+	// Unchanged means the key is present in both snapshots with equal elements.
+	Unchanged ChangeKind = iota
+	// Added means the key is present only in the new snapshot.
+	Added
+	// Removed means the key is present only in the old snapshot.
+	Removed
+	// Modified means the key is present in both snapshots with different elements.
+	Modified
+)
+
+// String implements fmt.Stringer.
+func (k ChangeKind) String() string {
+	switch k {
+	case Unchanged:
+		return "Unchanged"
+	case Added:
+		return "Added"
+	case Removed:
+		return "Removed"
+	case Modified:
+		return "Modified"
+	default:
+		return fmt.Sprintf("ChangeKind(%d)", int(k))
+	}
+}
+
+// Change describes one key of a diff between an old and a new snapshot.
+// Old is the zero value for Added changes and New is the zero value for Removed changes.
+type Change[K comparable, T any] struct {
+	Kind ChangeKind
+	Key  K
+	Old  T
+	New  T
+}
+
+// DiffBy compares an old and a new snapshot of records matched by key and returns a Stream of changes.
+// Elements with a key present in both snapshots are compared with equal and reported as Modified
+// or Unchanged; the rest are reported as Added or Removed.
+// This is a function (not a method) because in Go methods cannot have their own type parameters.
+//
+// ORDER: Changes for the elements of newItems come first, in their order (Added, Modified, Unchanged),
+// followed by the Removed elements in the order of oldItems.
+// If a key repeats, its occurrences are matched pairwise in source order; extra occurrences
+// are reported as Added or Removed.
+//
+// LAZY: oldItems is indexed on the first Next(); newItems is streamed one element at a time.
+//
+// SIZE: Unknown (the number of removals is known only at the end).
+//
+// Example:
+//
+//	changes := DiffBy(
+//	    From(yesterday), From(today),
+//	    func(u User) int { return u.ID },
+//	    func(a, b User) bool { return a == b },
+//	).Where(func(c Change[int, User]) bool { return c.Kind != Unchanged }).ToSlice()
+//
+//nolint:gocognit
+func DiffBy[T any, K comparable](
+	oldItems, newItems Enumerable[T],
+	keySelector func(T) K,
+	equal func(a, b T) bool,
+) Stream[Change[K, T]] {
+	return &stream[Change[K, T]]{
+		sourceFactory: func(sc *scope) func() (Change[K, T], bool) {
+			var olds []T
+			var oldKeys []K
+			var pending map[K][]int // Unmatched old indexes by key, in source order
+			var matched []bool
+			var source func() (T, bool)
+			started := false
+			removedIndex := 0
+
+			return func() (Change[K, T], bool) {
+				if !started {
+					// Index the old snapshot on first Next()
+					started = true
+					pending = make(map[K][]int)
+					next := iteratorOf(oldItems, sc)
+					for {
+						item, ok := next()
+						if !ok {
+							break
+						}
+						key := keySelector(item)
+						pending[key] = append(pending[key], len(olds))
+						olds = append(olds, item)
+						oldKeys = append(oldKeys, key)
+					}
+					matched = make([]bool, len(olds))
+					source = iteratorOf(newItems, sc)
+				}
+
+				if source != nil {
+					if item, ok := source(); ok {
+						key := keySelector(item)
+						indexes := pending[key]
+						if len(indexes) == 0 {
+							return Change[K, T]{Kind: Added, Key: key, New: item}, true
+						}
+						i := indexes[0]
+						pending[key] = indexes[1:]
+						matched[i] = true
+						kind := Modified
+						if equal(olds[i], item) {
+							kind = Unchanged
+						}
+						return Change[K, T]{Kind: kind, Key: key, Old: olds[i], New: item}, true
+					}
+					source = nil // New snapshot exhausted, report removals
+				}
+
+				for removedIndex < len(olds) {
+					i := removedIndex
+					removedIndex++
+					if !matched[i] {
+						return Change[K, T]{Kind: Removed, Key: oldKeys[i], Old: olds[i]}, true
+					}
+				}
+
+				var zero Change[K, T]
+				return zero, false
+			}
+		},
+		size:    -1, // UNKNOWN: removals are known only at the end
+		oneShot: isOneShot(oldItems) || isOneShot(newItems),
+	}
+}
+
+// MapDiff compares an old and a new map and returns a Stream of changes by key,
+// using equal to compare the values of keys present in both maps.
+// Changes for the keys of newMap come first, followed by the Removed keys, each in map
+// iteration order; sort by Key (for example with OrderByKey) for a deterministic order.
+// This is a function (not a method) because in Go methods cannot have their own type parameters.
+//
+// SIZE: Unknown (the number of removals is known only at the end).
+//
+// Example:
+//
+//	for _, c := range MapDiff(oldConfig, newConfig, func(a, b string) bool { return a == b }).ToSlice() {
+//	    if c.Kind != Unchanged {
+//	        fmt.Printf("%s %s: %q -> %q\n", c.Kind, c.Key, c.Old, c.New)
+//	    }
+//	}
+func MapDiff[K comparable, V any](oldMap, newMap map[K]V, equal func(a, b V) bool) Stream[Change[K, V]] {
+	changes := DiffBy(
+		FromMap(oldMap),
+		FromMap(newMap),
+		func(kv KeyValue[K, V]) K { return kv.Key },
+		func(a, b KeyValue[K, V]) bool { return equal(a.Value, b.Value) },
+	)
+	return Select(changes, func(c Change[K, KeyValue[K, V]]) Change[K, V] {
+		return Change[K, V]{Kind: c.Kind, Key: c.Key, Old: c.Old.Value, New: c.New.Value}
+	})
+}
+
+// EditOp is the operation of one step of an edit script.
+type EditOp int
+
+const (
+	// Keep means the element is present in both sequences.
+	Keep EditOp = iota
+	// Insert means the element is present only in the new sequence.
+	Insert
+	// Delete means the element is present only in the old sequence.
+	Delete
+)
+
+// String implements fmt.Stringer.
+func (op EditOp) String() string {
+	switch op {
+	case Keep:
+		return "Keep"
+	case Insert:
+		return "Insert"
+	case Delete:
+		return "Delete"
+	default:
+		return fmt.Sprintf("EditOp(%d)", int(op))
+	}
+}
+
+// Edit is one step of an edit script that turns an old sequence into a new one.
+// OldIndex is the position of Value in the old sequence (-1 for Insert) and NewIndex
+// its position in the new sequence (-1 for Delete). For Keep, Value is the old element.
+type Edit[T any] struct {
+	Op       EditOp
+	Value    T
+	OldIndex int
+	NewIndex int
+}
+
+// SequenceDiff computes the shortest edit script that turns oldItems into newItems (Myers' algorithm),
+// as a Stream of Keep, Insert and Delete steps in sequence order. Within a change, deletions come
+// before insertions.
+// This is a function (not a method) because in Go methods cannot have their own type parameters.
+//
+// NOTE: Both sequences are materialized on the first Next() of each iteration.
+// The script is computed in O((N+M)·D) time and O(D²) memory, where D is the number of edits.
+//
+// SIZE: Unknown (the length of the script is known only after the diff is computed).
+//
+// Example:
+//
+//	script := SequenceDiff(
+//	    From([]string{"a", "b", "c"}),
+//	    From([]string{"a", "c", "d"}),
+//	    func(x, y string) bool { return x == y },
+//	).ToSlice()
+//	// Keep a, Delete b, Keep c, Insert d
+func SequenceDiff[T any](oldItems, newItems Enumerable[T], equal func(a, b T) bool) Stream[Edit[T]] {
+	return &stream[Edit[T]]{
+		sourceFactory: func(sc *scope) func() (Edit[T], bool) {
+			return deferredIterator(func() []Edit[T] {
+				return editScript(FromEnumerable(oldItems).ToSlice(), FromEnumerable(newItems).ToSlice(), equal)
+			})
+		},
+		size:    -1, // UNKNOWN: script length is known only after the diff
+		oneShot: isOneShot(oldItems) || isOneShot(newItems),
+	}
+}
+
+// editScript finds the shortest edit script from a to b with Myers' greedy algorithm.
+// trace[d] holds the furthest x reached on every diagonal k in [-d, d] before round d,
+// which is enough to walk the path back from the end.
+func editScript[T any](a, b []T, equal func(x, y T) bool) []Edit[T] {
+	n, m := len(a), len(b)
+	offset := n + m + 1
+	v := make([]int, 2*offset+1) // v[offset+k] is the furthest x on diagonal k = x - y
+	var trace [][]int
+
+	for d := 0; d <= n+m; d++ {
+		trace = append(trace, slices.Clone(v[offset-d:offset+d+1]))
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1] // Move down: insert b[y]
+			} else {
+				x = v[offset+k-1] + 1 // Move right: delete a[x]
+			}
+			y := x - k
+			for x < n && y < m && equal(a[x], b[y]) {
+				x, y = x+1, y+1 // Follow the diagonal of equal elements
+			}
+			v[offset+k] = x
+			if x >= n && y >= m {
+				return backtrackEdits(a, b, trace)
+			}
+		}
+	}
+	return nil // Unreachable: d = n+m always reaches the end
+}
+
+// backtrackEdits walks the paths recorded by editScript back from (len(a), len(b)) to (0, 0).
+func backtrackEdits[T any](a, b []T, trace [][]int) []Edit[T] {
+	var edits []Edit[T]
+	x, y := len(a), len(b)
+	for d := len(trace) - 1; d >= 0; d-- {
+		prevX, prevY := 0, 0 // Round 0 starts at the origin
+		if d > 0 {
+			furthest := func(k int) int { return trace[d][k+d] }
+			k := x - y
+			prevK := k - 1
+			if k == -d || (k != d && furthest(k-1) < furthest(k+1)) {
+				prevK = k + 1
+			}
+			prevX = furthest(prevK)
+			prevY = prevX - prevK
+		}
+
+		for x > prevX && y > prevY {
+			x, y = x-1, y-1
+			edits = append(edits, Edit[T]{Op: Keep, Value: a[x], OldIndex: x, NewIndex: y})
+		}
+		if d == 0 {
+			break
+		}
+		if x == prevX {
+			edits = append(edits, Edit[T]{Op: Insert, Value: b[prevY], OldIndex: -1, NewIndex: prevY})
+		} else {
+			edits = append(edits, Edit[T]{Op: Delete, Value: a[prevX], OldIndex: prevX, NewIndex: -1})
+		}
+		x, y = prevX, prevY
+	}
+	slices.Reverse(edits)
+	return edits
+}
+
+// UnifiedDiff formats the differences between two sequences of lines in unified diff style:
+// hunks with "@@ -start,count +start,count @@" headers followed by the changed lines prefixed
+// with "-" or "+" and up to context unchanged lines around them prefixed with a space.
+// Returns an empty string if the sequences are equal. Negative context values are treated as 0.
+//
+// Example:
+//
+//	if diff := UnifiedDiff(From(wantLines), From(gotLines), 3); diff != "" {
+//	    t.Errorf("output mismatch (-want +got):\n%s", diff)
+//	}
+func UnifiedDiff(oldLines, newLines Enumerable[string], context int) string {
+	if context < 0 {
+		context = 0
+	}
+	edits := SequenceDiff(oldLines, newLines, func(a, b string) bool { return a == b }).ToSlice()
+
+	// oldAt[i] and newAt[i] count the old and new lines before edits[i]
+	oldAt := make([]int, len(edits)+1)
+	newAt := make([]int, len(edits)+1)
+	for i, edit := range edits {
+		oldAt[i+1], newAt[i+1] = oldAt[i], newAt[i]
+		if edit.Op != Insert {
+			oldAt[i+1]++
+		}
+		if edit.Op != Delete {
+			newAt[i+1]++
+		}
+	}
+
+	var sb strings.Builder
+	for i := 0; i < len(edits); {
+		if edits[i].Op == Keep {
+			i++
+			continue
+		}
+
+		// Extend the hunk while the next change is within 2*context unchanged lines
+		last := i
+		for {
+			next := last + 1
+			for next < len(edits) && edits[next].Op == Keep {
+				next++
+			}
+			if next == len(edits) || next-last-1 > 2*context {
+				break
+			}
+			last = next
+		}
+
+		start, end := max(i-context, 0), min(last+context+1, len(edits))
+		fmt.Fprintf(&sb, "@@ -%s +%s @@\n",
+			hunkRange(oldAt[start], oldAt[end]-oldAt[start]),
+			hunkRange(newAt[start], newAt[end]-newAt[start]))
+		for _, edit := range edits[start:end] {
+			prefix := " "
+			switch edit.Op {
+			case Insert:
+				prefix = "+"
+			case Delete:
+				prefix = "-"
+			}
+			sb.WriteString(prefix)
+			sb.WriteString(edit.Value)
+			sb.WriteString("\n")
+		}
+		i = end
+	}
+	return sb.String()
+}
+
+// hunkRange formats the line range of a hunk side the way diff -u does:
+// 1-based start, count omitted when it is 1, and the preceding line as start when the side is empty.
+func hunkRange(before, count int) string {
+	switch count {
+	case 0:
+		return fmt.Sprintf("%d,0", before)
+	case 1:
+		return fmt.Sprintf("%d", before+1)
+	default:
+		return fmt.Sprintf("%d,%d", before+1, count)
+	}
+}
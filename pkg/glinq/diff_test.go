@@ -0,0 +1,311 @@
+package glinq
+
+import (
+	"math/rand"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+)
+
+type diffRecord struct {
+	ID   int
+	Name string
+}
+
+func TestDiffBy(t *testing.T) {
+	byID := func(r diffRecord) int { return r.ID }
+	equal := func(a, b diffRecord) bool { return a == b }
+
+	t.Run("All change kinds", func(t *testing.T) {
+		oldItems := []diffRecord{{1, "a"}, {2, "b"}, {3, "c"}, {4, "d"}}
+		newItems := []diffRecord{{3, "c"}, {5, "e"}, {2, "B"}}
+
+		result := DiffBy(From(oldItems), From(newItems), byID, equal).ToSlice()
+		expected := []Change[int, diffRecord]{
+			{Kind: Unchanged, Key: 3, Old: diffRecord{3, "c"}, New: diffRecord{3, "c"}},
+			{Kind: Added, Key: 5, New: diffRecord{5, "e"}},
+			{Kind: Modified, Key: 2, Old: diffRecord{2, "b"}, New: diffRecord{2, "B"}},
+			{Kind: Removed, Key: 1, Old: diffRecord{1, "a"}},
+			{Kind: Removed, Key: 4, Old: diffRecord{4, "d"}},
+		}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("Expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("Empty sides", func(t *testing.T) {
+		items := []diffRecord{{1, "a"}, {2, "b"}}
+
+		added := DiffBy(Empty[diffRecord](), From(items), byID, equal).ToSlice()
+		for _, c := range added {
+			if c.Kind != Added {
+				t.Errorf("Expected Added, got %v", c.Kind)
+			}
+		}
+		removed := DiffBy(From(items), Empty[diffRecord](), byID, equal).ToSlice()
+		for _, c := range removed {
+			if c.Kind != Removed {
+				t.Errorf("Expected Removed, got %v", c.Kind)
+			}
+		}
+		if len(added) != 2 || len(removed) != 2 {
+			t.Errorf("Expected 2 changes each, got %d and %d", len(added), len(removed))
+		}
+		if result := DiffBy(Empty[diffRecord](), Empty[diffRecord](), byID, equal).ToSlice(); len(result) != 0 {
+			t.Errorf("Expected no changes, got %v", result)
+		}
+	})
+
+	t.Run("Repeated keys are matched pairwise", func(t *testing.T) {
+		oldItems := []diffRecord{{1, "a"}, {1, "b"}, {1, "c"}}
+		newItems := []diffRecord{{1, "a"}, {1, "x"}}
+
+		var kinds []ChangeKind
+		for _, c := range DiffBy(From(oldItems), From(newItems), byID, equal).ToSlice() {
+			kinds = append(kinds, c.Kind)
+		}
+		expected := []ChangeKind{Unchanged, Modified, Removed}
+		if !reflect.DeepEqual(kinds, expected) {
+			t.Errorf("Expected %v, got %v", expected, kinds)
+		}
+	})
+
+	t.Run("Lazy", func(t *testing.T) {
+		pulled := 0
+		newItems := Select(From([]diffRecord{{1, "a"}, {2, "b"}, {3, "c"}}), func(r diffRecord) diffRecord {
+			pulled++
+			return r
+		})
+		diff := DiffBy(From([]diffRecord{{1, "a"}}), newItems, byID, equal)
+		if pulled != 0 {
+			t.Errorf("Expected no pulls before iteration, got %d", pulled)
+		}
+
+		if c, ok := diff.First(); !ok || c.Kind != Unchanged {
+			t.Errorf("Expected Unchanged first, got %v (%v)", c, ok)
+		}
+		if pulled != 1 {
+			t.Errorf("Expected 1 pull from the new snapshot, got %d", pulled)
+		}
+	})
+
+	t.Run("Re-iterable", func(t *testing.T) {
+		diff := DiffBy(From([]diffRecord{{1, "a"}}), From([]diffRecord{{2, "b"}}), byID, equal)
+		first := diff.ToSlice()
+		second := diff.ToSlice()
+		if !reflect.DeepEqual(first, second) || len(first) != 2 {
+			t.Errorf("Expected two equal iterations of 2 changes, got %v and %v", first, second)
+		}
+	})
+}
+
+func TestMapDiff(t *testing.T) {
+	oldMap := map[string]int{"a": 1, "b": 2, "c": 3}
+	newMap := map[string]int{"a": 1, "b": 20, "d": 4}
+
+	changes := MapDiff(oldMap, newMap, func(a, b int) bool { return a == b })
+	result := OrderByKey(changes, func(c Change[string, int]) string { return c.Key }).ToSlice()
+	expected := []Change[string, int]{
+		{Kind: Unchanged, Key: "a", Old: 1, New: 1},
+		{Kind: Modified, Key: "b", Old: 2, New: 20},
+		{Kind: Removed, Key: "c", Old: 3},
+		{Kind: Added, Key: "d", New: 4},
+	}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+}
+
+func TestChangeKindString(t *testing.T) {
+	tests := map[ChangeKind]string{
+		Unchanged:      "Unchanged",
+		Added:          "Added",
+		Removed:        "Removed",
+		Modified:       "Modified",
+		ChangeKind(42): "ChangeKind(42)",
+	}
+	for kind, expected := range tests {
+		if kind.String() != expected {
+			t.Errorf("Expected %q, got %q", expected, kind.String())
+		}
+	}
+}
+
+// applyEdits rebuilds the old and new sequences from an edit script.
+func applyEdits[T any](edits []Edit[T]) (oldItems, newItems []T) {
+	for _, e := range edits {
+		switch e.Op {
+		case Keep:
+			oldItems = append(oldItems, e.Value)
+			newItems = append(newItems, e.Value)
+		case Delete:
+			oldItems = append(oldItems, e.Value)
+		case Insert:
+			newItems = append(newItems, e.Value)
+		}
+	}
+	return oldItems, newItems
+}
+
+// lcsLength is the reference dynamic-programming LCS used to check that scripts are minimal.
+func lcsLength(a, b []int) int {
+	dp := make([][]int, len(a)+1)
+	for i := range dp {
+		dp[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				dp[i][j] = dp[i+1][j+1] + 1
+			} else {
+				dp[i][j] = max(dp[i+1][j], dp[i][j+1])
+			}
+		}
+	}
+	return dp[0][0]
+}
+
+func TestSequenceDiff(t *testing.T) {
+	equal := func(a, b string) bool { return a == b }
+
+	t.Run("Edit script", func(t *testing.T) {
+		result := SequenceDiff(From([]string{"a", "b", "c"}), From([]string{"a", "c", "d"}), equal).ToSlice()
+		expected := []Edit[string]{
+			{Op: Keep, Value: "a", OldIndex: 0, NewIndex: 0},
+			{Op: Delete, Value: "b", OldIndex: 1, NewIndex: -1},
+			{Op: Keep, Value: "c", OldIndex: 2, NewIndex: 1},
+			{Op: Insert, Value: "d", OldIndex: -1, NewIndex: 2},
+		}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("Expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("Deletions before insertions", func(t *testing.T) {
+		result := SequenceDiff(From([]string{"a", "x", "c"}), From([]string{"a", "y", "c"}), equal).ToSlice()
+		var ops []EditOp
+		for _, e := range result {
+			ops = append(ops, e.Op)
+		}
+		if expected := []EditOp{Keep, Delete, Insert, Keep}; !reflect.DeepEqual(ops, expected) {
+			t.Errorf("Expected %v, got %v", expected, ops)
+		}
+	})
+
+	t.Run("Empty sides", func(t *testing.T) {
+		if result := SequenceDiff(Empty[string](), Empty[string](), equal).ToSlice(); len(result) != 0 {
+			t.Errorf("Expected empty script, got %v", result)
+		}
+		for _, e := range SequenceDiff(Empty[string](), From([]string{"a", "b"}), equal).ToSlice() {
+			if e.Op != Insert {
+				t.Errorf("Expected only inserts, got %v", e)
+			}
+		}
+		for _, e := range SequenceDiff(From([]string{"a", "b"}), Empty[string](), equal).ToSlice() {
+			if e.Op != Delete {
+				t.Errorf("Expected only deletes, got %v", e)
+			}
+		}
+	})
+
+	t.Run("Minimal and consistent on random input", func(t *testing.T) {
+		rng := rand.New(rand.NewSource(1)) //nolint:gosec
+		for round := 0; round < 200; round++ {
+			a := make([]int, rng.Intn(30))
+			for i := range a {
+				a[i] = rng.Intn(4)
+			}
+			b := make([]int, rng.Intn(30))
+			for i := range b {
+				b[i] = rng.Intn(4)
+			}
+
+			edits := SequenceDiff(From(a), From(b), func(x, y int) bool { return x == y }).ToSlice()
+			gotOld, gotNew := applyEdits(edits)
+			if !slices.Equal(gotOld, a) || !slices.Equal(gotNew, b) {
+				t.Fatalf("Script %v does not turn %v into %v", edits, a, b)
+			}
+
+			keeps := 0
+			for _, e := range edits {
+				if e.Op == Keep {
+					keeps++
+				}
+			}
+			if lcs := lcsLength(a, b); keeps != lcs {
+				t.Fatalf("Expected %d kept elements for %v -> %v, got %d", lcs, a, b, keeps)
+			}
+		}
+	})
+
+	t.Run("Lazy", func(t *testing.T) {
+		pulled := 0
+		source := Select(From([]string{"a"}), func(s string) string {
+			pulled++
+			return s
+		})
+		diff := SequenceDiff(source, From([]string{"b"}), equal)
+		if pulled != 0 {
+			t.Errorf("Expected no pulls before iteration, got %d", pulled)
+		}
+		if len(diff.ToSlice()) != 2 || pulled != 1 {
+			t.Errorf("Expected 2 edits after pulling once, pulled %d", pulled)
+		}
+	})
+}
+
+func TestUnifiedDiff(t *testing.T) {
+	lines := func(s string) Stream[string] {
+		if s == "" {
+			return Empty[string]()
+		}
+		return From(strings.Split(s, " "))
+	}
+
+	tests := []struct {
+		name     string
+		oldLines string
+		newLines string
+		context  int
+		expected string
+	}{
+		{"Equal", "a b c", "a b c", 3, ""},
+		{
+			"Separate hunks",
+			"a b c d e f g h i j k",
+			"a B c d e f g h i k l",
+			1,
+			"@@ -1,3 +1,3 @@\n a\n-b\n+B\n c\n@@ -9,3 +9,3 @@\n i\n-j\n k\n+l\n",
+		},
+		{
+			"Merged hunk",
+			"a b c d e",
+			"a B c D e",
+			1,
+			"@@ -1,5 +1,5 @@\n a\n-b\n+B\n c\n-d\n+D\n e\n",
+		},
+		{"Zero context", "a b c", "a x c", 0, "@@ -2 +2 @@\n-b\n+x\n"},
+		{"Negative context", "a b c", "a x c", -1, "@@ -2 +2 @@\n-b\n+x\n"},
+		{"Insert into empty", "", "x", 3, "@@ -0,0 +1 @@\n+x\n"},
+		{"Delete everything", "x", "", 3, "@@ -1 +0,0 @@\n-x\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := UnifiedDiff(lines(tt.oldLines), lines(tt.newLines), tt.context)
+			if result != tt.expected {
+				t.Errorf("Expected:\n%s\ngot:\n%s", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestEditOpString(t *testing.T) {
+	tests := map[EditOp]string{Keep: "Keep", Insert: "Insert", Delete: "Delete", EditOp(7): "EditOp(7)"}
+	for op, expected := range tests {
+		if op.String() != expected {
+			t.Errorf("Expected %q, got %q", expected, op.String())
+		}
+	}
+}
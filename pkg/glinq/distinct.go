@@ -7,12 +7,13 @@ package glinq
 // SIZE: Loses size (unknown how many duplicates exist).
 func Distinct[T comparable](enum Enumerable[T]) Stream[T] {
 	return &stream[T]{
-		sourceFactory: func() func() (T, bool) {
-			seen := make(map[T]bool) // Fresh map for each iterator
+		sourceFactory: func(sc *scope) func() (T, bool) {
+			source := iteratorOf(enum, sc) // Get fresh source
+			seen := make(map[T]bool)       // Fresh map for each iterator
 
 			return func() (T, bool) {
 				for {
-					val, ok := enum.Next()
+					val, ok := source()
 					if !ok {
 						var zero T
 						return zero, false
@@ -25,7 +26,8 @@ func Distinct[T comparable](enum Enumerable[T]) Stream[T] {
 				}
 			}
 		},
-		size: -1, // LOSE: unknown how many duplicates
+		size:    -1, // LOSE: unknown how many duplicates
+		oneShot: isOneShot(enum),
 	}
 }
 
@@ -34,9 +36,47 @@ func Distinct[T comparable](enum Enumerable[T]) Stream[T] {
 // SIZE: Loses size (unknown how many duplicates exist).
 func (s *stream[T]) DistinctBy(keySelector func(T) any) Stream[T] {
 	return &stream[T]{
-		sourceFactory: func() func() (T, bool) {
-			source := s.sourceFactory() // Get fresh source
-			seen := make(map[any]bool)  // Fresh map for each iterator
+		sourceFactory: func(sc *scope) func() (T, bool) {
+			source := s.sourceFactory(sc) // Get fresh source
+			seen := make(map[any]bool)    // Fresh map for each iterator
+
+			return func() (T, bool) {
+				for {
+					val, ok := source()
+					if !ok {
+						var zero T
+						return zero, false
+					}
+
+					key := keySelector(val)
+					if !seen[key] {
+						seen[key] = true
+						return val, true
+					}
+				}
+			}
+		},
+		size:    -1, // LOSE: unknown how many duplicates
+		oneShot: s.oneShot,
+	}
+}
+
+// DistinctByKey removes duplicates by a typed key extracted by keySelector.
+// Unlike the DistinctBy method, the key type is checked at compile time and keys are not boxed.
+// The first element with each key is kept.
+// This is a function (not a method) because methods cannot have their own type parameters.
+//
+// SIZE: Loses size (unknown how many duplicates exist).
+//
+// Example:
+//
+//	type Person struct { ID int; Name string }
+//	unique := DistinctByKey(From(people), func(p Person) int { return p.ID }).ToSlice()
+func DistinctByKey[T any, K comparable](enum Enumerable[T], keySelector func(T) K) Stream[T] {
+	return &stream[T]{
+		sourceFactory: func(sc *scope) func() (T, bool) {
+			source := iteratorOf(enum, sc) // Get fresh source
+			seen := make(map[K]bool)       // Fresh map for each iterator
 
 			return func() (T, bool) {
 				for {
@@ -54,6 +94,42 @@ func (s *stream[T]) DistinctBy(keySelector func(T) any) Stream[T] {
 				}
 			}
 		},
-		size: -1, // LOSE: unknown how many duplicates
+		size:    -1, // LOSE: unknown how many duplicates
+		oneShot: isOneShot(enum),
+	}
+}
+
+// DistinctWith removes duplicates using comparer to decide equality.
+// The first element of each set of equal elements is kept.
+// This is a function (not a method) because in Go methods cannot have their own type parameters.
+//
+// SIZE: Loses size (unknown how many duplicates exist).
+//
+// Example:
+//
+//	tags := DistinctWith(From([]string{"Go", "go", "Rust"}), StringFoldComparer()).ToSlice()
+//	// ["Go", "Rust"]
+func DistinctWith[T any](enum Enumerable[T], comparer EqualityComparer[T]) Stream[T] {
+	return &stream[T]{
+		sourceFactory: func(sc *scope) func() (T, bool) {
+			source := iteratorOf(enum, sc) // Get fresh source
+			seen := newHashIndex(comparer) // Fresh index for each iterator
+
+			return func() (T, bool) {
+				for {
+					val, ok := source()
+					if !ok {
+						var zero T
+						return zero, false
+					}
+
+					if _, added := seen.add(val); added {
+						return val, true
+					}
+				}
+			}
+		},
+		size:    -1, // LOSE: unknown how many duplicates
+		oneShot: isOneShot(enum),
 	}
 }
@@ -83,3 +83,41 @@ func TestDistinctBy(t *testing.T) {
 		}
 	})
 }
+
+func TestDistinctByKey(t *testing.T) {
+	type Person struct {
+		ID   int
+		Name string
+		Tags []string // Not comparable: the whole struct cannot be a map key
+	}
+
+	people := []Person{
+		{1, "Alice", []string{"a"}},
+		{2, "Bob", nil},
+		{1, "Alice (dup)", []string{"b"}},
+	}
+
+	result := DistinctByKey(From(people), func(p Person) int { return p.ID }).ToSlice()
+
+	if len(result) != 2 {
+		t.Fatalf("Expected 2 unique people, got %d", len(result))
+	}
+	if result[0].Name != "Alice" || result[1].Name != "Bob" {
+		t.Errorf("Expected first occurrences to be kept, got %v", result)
+	}
+}
+
+func TestDistinctWith(t *testing.T) {
+	t.Run("Case-insensitive strings", func(t *testing.T) {
+		result := DistinctWith(From([]string{"Go", "GO", "rust", "go", "Rust"}), StringFoldComparer())
+		assertReiterable(t, "DistinctWith", result, []string{"Go", "rust"})
+	})
+
+	t.Run("Non-comparable elements", func(t *testing.T) {
+		result := DistinctWith(From([][]int{{1, 2}, {3}, {1, 2}, {}}), DeepEqualComparer[[]int]()).ToSlice()
+		expected := [][]int{{1, 2}, {3}, {}}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("Expected %v, got %v", expected, result)
+		}
+	})
+}
@@ -27,7 +27,14 @@
 //   - From: from a slice
 //   - Empty: empty Stream
 //   - Range: stream of integers
+//   - Repeat: a value repeated n times
+//   - RepeatForever / Generate / Iterate / Cycle: infinite streams (bound them with Take or TakeWhile)
+//   - Unfold: from a seed state and a step function
 //   - FromMap: from a map (returns KeyValue pairs)
+//   - FromMapSorted / FromMapOrderedBy: from a map in a deterministic key order
+//   - FromSeq / FromSeq2: from Go range-over-func iterators
+//   - FromChannel / FromChannelContext: receive from a channel until it is closed
+//   - FromResource: from a resource (file, cursor, ...) released exactly once per iteration
 //
 // Operators (transform Stream):
 //   - Where: filter by predicate
@@ -36,27 +43,77 @@
 //   - TakeWhile: take elements while predicate returns true
 //   - Skip: skip first n elements
 //   - SkipWhile: skip elements while predicate returns true
-//   - Reverse: reverse order of elements (materializes stream)
+//   - OrderBy / OrderByDescending: stable sort, chain ThenBy / ThenByDescending for secondary keys
+//   - OrderByKey / ThenByKey: sort by Ordered keys without writing comparators (function)
+//   - Reverse: reverse order of elements (O(1) over indexable sources, otherwise buffered on first Next)
 //   - SelectMany: flatten sequences (function, not method)
-//   - GroupBy: group elements by key (function, returns KeyValue pairs)
-//   - Zip: combine two sequences using result selector (function)
+//   - GroupBy: group elements by key, lazily and in first-seen key order (function, returns KeyValue pairs)
+//   - Zip / Zip3 / ZipN: combine sequences element by element, stopping at the shortest (function)
+//   - ZipLongest / ZipLongestFill: zip until the longest sequence ends (function)
+//   - ZipExact: zip sequences that must have equal lengths, as a ResultStream (function)
+//   - Unzip: split a sequence of Pair values into two Streams (function)
+//   - Union / Intersect / Except and UnionBy / IntersectBy / ExceptBy: set operations, optionally by key (function)
+//   - SymmetricDifference / IntersectAll / ExceptAll: exclusive-or and multiset set operations (function)
+//   - MergeSorted: lazy k-way merge of pre-sorted sequences (function)
+//   - SortedUnion / SortedIntersect / SortedExcept: linear set operations on sorted input (function)
+//   - DistinctWith / GroupByWith / UnionWith / IntersectWith / ExceptWith: custom equality (function)
+//   - JoinWith / GroupJoinWith / LeftJoinWith / FullOuterJoinWith: joins with custom key equality (function)
+//   - DistinctByKey: remove duplicates by a typed comparable key (function)
+//   - Scan: running aggregate emitting every intermediate accumulator (function)
+//   - Chunked / Window / Pairwise / ChunkBy: lazy chunks, sliding windows, adjacent pairs and runs (function)
+//   - Join / GroupJoin / LeftJoin / FullOuterJoin: hash-based joins on key selectors (function)
+//   - ParallelSelect / ParallelWhere: process elements on a bounded goroutine pool (function)
+//   - DiffBy / MapDiff: keyed diff of two snapshots as Added / Removed / Modified / Unchanged changes (function)
+//   - SequenceDiff: ordered diff as a Keep / Insert / Delete edit script (function)
+//   - UnifiedDiff: format the difference of two line sequences in unified diff style (function)
 //
 // Terminal operations (materialize result):
 //   - ToSlice: convert to slice
-//   - First: first element
-//   - Last: last element
+//   - ToSet: distinct elements as map[T]struct{} (function)
+//   - First / FirstWhere / FirstOrDefault: first element (optionally matching a predicate)
+//   - Last / LastWhere / LastOrDefault: last element (optionally matching a predicate)
+//   - Single / SingleOrDefault: the only element, or ErrNoElements / ErrMoreThanOneElement
 //   - ElementAt: element at specified index
 //   - ElementAtOrDefault: element at index or default value
 //   - Contains: check if stream contains element
 //   - ContainsBy: check if stream contains element matching key (with custom key selector)
+//   - ContainsKey: typed, compile-time checked variant of ContainsBy (function)
+//   - ContainsWith: check membership under an EqualityComparer (function)
 //   - Count: number of elements
 //   - Any: check if any element exists
 //   - All: check if all elements satisfy condition
 //   - ForEach: execute action for each element
+//   - ParallelForEach: execute action concurrently for each element (function)
+//   - Fold / AggregateWithResult: typed reductions (function)
+//   - ToChannel: send elements to a channel from a producer goroutine
+//   - Seq: range-over-func iterator (ToSeq / ToSeq2 for any Enumerable)
+//
+// Equality comparers (EqualityComparer, used by the With functions):
+//   - DefaultComparer: == for comparable types
+//   - DeepEqualComparer: reflect.DeepEqual for any type
+//   - StringFoldComparer: case-insensitive strings
+//   - FloatComparer: floats within an epsilon
+//   - NewComparer: from custom equality and hash functions
+//
+// Numeric aggregates (functions):
+//   - Sum / SumBy, Min, Max, MinBy / MaxBy (typed key selectors)
+//   - Average / AverageBy, Median, Percentile
+//   - Variance / SampleVariance, StdDev / SampleStdDev (single pass)
+//
+// Fallible pipelines (ResultStream):
+//   - WithContext: lift an Enumerable into a cancellable ResultStream
+//   - FromFallible: from a source that may return errors
+//   - FromFallibleResource: from a fallible resource (file, cursor, ...) released exactly once per iteration
+//   - TrySelect: transform elements with a mapper that may fail
 //
 // Helper functions for working with KeyValue:
 //   - Keys: extract keys
 //   - Values: extract values
-//   - ToMap: convert to map
+//   - MapKeys / MapValues / FilterKeys / SwapKeyValue: transform or filter pairs
+//   - SortByKey / ToSortedPairs: order pairs by key
+//   - ToMap: convert to map (last value wins for duplicate keys)
+//   - ToMapWith / ToMapMerge: convert to map with KeepFirst, KeepLast, ErrorOnDuplicate or MergeValues
+//   - ToMultiMap: convert to map[K][]V keeping every value
 //   - GroupBy: group elements by key selector
+//   - ToLookup: materialize groups into a Lookup with Get, Contains, Count and Keys
 package glinq
@@ -0,0 +1,345 @@
+package glinq
+
+import (
+	"hash/maphash"
+	"math"
+	"reflect"
+	"strings"
+	"unicode"
+)
+
+// EqualityComparer defines equality for elements or keys of type T.
+// It is accepted by the With variants of the operators that compare elements
+// (ContainsWith, DistinctWith, GroupByWith, UnionWith, IntersectWith, ExceptWith, JoinWith,
+// GroupJoinWith, LeftJoinWith, FullOuterJoinWith).
+//
+// Implementations must be consistent: if Equals(a, b) is true, Hash(a) must equal Hash(b).
+// Values with equal hashes are told apart with Equals.
+type EqualityComparer[T any] interface {
+	// Equals reports whether a and b are equal.
+	Equals(a, b T) bool
+	// Hash returns a hash code for value.
+	Hash(value T) uint64
+}
+
+// hashSeed seeds every hash computed by the built-in comparers.
+//
+//nolint:gochecknoglobals
+var hashSeed = maphash.MakeSeed()
+
+// maxHashDepth bounds the recursion of deepHash through pointers and nested values
+// (reflect.DeepEqual tolerates cyclic values, so hashing must stop somewhere).
+const maxHashDepth = 8
+
+// NewComparer creates an EqualityComparer from an equality function and a consistent hash function.
+//
+// Example:
+//
+//	byID := NewComparer(
+//	    func(a, b Person) bool { return a.ID == b.ID },
+//	    func(p Person) uint64 { return uint64(p.ID) },
+//	)
+func NewComparer[T any](equals func(a, b T) bool, hash func(T) uint64) EqualityComparer[T] {
+	return funcComparer[T]{equals: equals, hash: hash}
+}
+
+// funcComparer is the EqualityComparer returned by NewComparer.
+type funcComparer[T any] struct {
+	equals func(a, b T) bool
+	hash   func(T) uint64
+}
+
+func (c funcComparer[T]) Equals(a, b T) bool  { return c.equals(a, b) }
+func (c funcComparer[T]) Hash(value T) uint64 { return c.hash(value) }
+
+// DefaultComparer returns an EqualityComparer that compares values with ==.
+// Pointers, channels and interfaces holding them are hashed by identity, as == compares them,
+// so mutating a pointee never changes its hash. The With operators index its values in a Go map.
+//
+// Example:
+//
+//	unique := DistinctWith(From([]int{1, 2, 2}), DefaultComparer[int]()).ToSlice()
+//	// [1, 2]
+func DefaultComparer[T comparable]() EqualityComparer[T] {
+	return defaultComparer[T]{}
+}
+
+// defaultComparer is the EqualityComparer returned by DefaultComparer.
+type defaultComparer[T comparable] struct{}
+
+func (defaultComparer[T]) Equals(a, b T) bool  { return a == b }
+func (defaultComparer[T]) Hash(value T) uint64 { return identityHash(value) }
+
+// newIndex lets newHashIndex use a Go map: == is exactly the equality of map keys.
+func (defaultComparer[T]) newIndex() keyIndex[T] { return newMapIndex[T]() }
+
+// DeepEqualComparer returns an EqualityComparer that compares values with reflect.DeepEqual.
+// It works with all types, including slices, maps and structs containing them.
+// Booleans, numbers and strings are compared with == without reflection.
+//
+// Example:
+//
+//	unique := DistinctWith(From([][]int{{1}, {1}, {2}}), DeepEqualComparer[[]int]()).ToSlice()
+//	// [[1], [2]]
+func DeepEqualComparer[T any]() EqualityComparer[T] {
+	return deepEqualComparer[T]{scalar: isScalar(reflect.TypeFor[T]())}
+}
+
+// deepEqualComparer is the EqualityComparer returned by DeepEqualComparer.
+type deepEqualComparer[T any] struct {
+	scalar bool // T is a boolean, number or string type: == matches reflect.DeepEqual
+}
+
+func (c deepEqualComparer[T]) Equals(a, b T) bool {
+	if c.scalar {
+		return any(a) == any(b)
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+func (deepEqualComparer[T]) Hash(value T) uint64 { return deepHash(value) }
+
+// StringFoldComparer returns an EqualityComparer for case-insensitive strings.
+// Strings are equal under Unicode simple case folding, as reported by strings.EqualFold.
+//
+// Example:
+//
+//	unique := DistinctWith(From([]string{"Go", "GO", "go", "Rust"}), StringFoldComparer()).ToSlice()
+//	// ["Go", "Rust"]
+func StringFoldComparer() EqualityComparer[string] {
+	return stringFoldComparer{}
+}
+
+// stringFoldComparer is the EqualityComparer returned by StringFoldComparer.
+type stringFoldComparer struct{}
+
+func (stringFoldComparer) Equals(a, b string) bool { return strings.EqualFold(a, b) }
+
+func (stringFoldComparer) Hash(value string) uint64 {
+	return maphash.String(hashSeed, strings.Map(foldRune, value))
+}
+
+// foldRune maps r to the smallest rune of its simple case folding orbit,
+// so that all case variants of a letter hash identically.
+func foldRune(r rune) rune {
+	smallest := r
+	for f := unicode.SimpleFold(r); f != r; f = unicode.SimpleFold(f) {
+		if f < smallest {
+			smallest = f
+		}
+	}
+	return smallest
+}
+
+// FloatComparer returns an EqualityComparer that treats floats as equal when they differ by at most epsilon.
+//
+// NOTE: Tolerance equality is not transitive (a ≈ b and b ≈ c does not imply a ≈ c), so it cannot be
+// hashed consistently. All values share one hash bucket: lookups are linear, and which of several
+// nearly-equal values is kept depends on source order.
+//
+// Example:
+//
+//	hasPi := ContainsWith(From(measurements), 3.14159, FloatComparer(1e-4))
+func FloatComparer(epsilon float64) EqualityComparer[float64] {
+	return floatComparer{epsilon: epsilon}
+}
+
+// floatComparer is the EqualityComparer returned by FloatComparer.
+type floatComparer struct {
+	epsilon float64
+}
+
+func (c floatComparer) Equals(a, b float64) bool { return a == b || math.Abs(a-b) <= c.epsilon }
+func (floatComparer) Hash(float64) uint64        { return 0 }
+
+// isScalar reports whether t is a boolean, numeric or string type,
+// for which == and reflect.DeepEqual agree.
+func isScalar(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Bool, reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64, reflect.Complex64, reflect.Complex128:
+		return true
+	default:
+		return false
+	}
+}
+
+// deepHash hashes value consistently with reflect.DeepEqual: deeply equal values have the same hash.
+// Pointers and interfaces are hashed by what they point to, maps only by their length.
+func deepHash[T any](value T) uint64 {
+	return hashValue(value, false)
+}
+
+// identityHash hashes value consistently with ==: equal values have the same hash.
+// Pointers and channels are hashed by address, interfaces by the value they hold.
+func identityHash[T any](value T) uint64 {
+	return hashValue(value, true)
+}
+
+func hashValue[T any](value T, identity bool) uint64 {
+	if s, ok := any(value).(string); ok {
+		return maphash.String(hashSeed, s) // Fast path for the most common key type
+	}
+	var h maphash.Hash
+	h.SetSeed(hashSeed)
+	writeHash(&h, reflect.ValueOf(&value).Elem(), 0, identity)
+	return h.Sum64()
+}
+
+// writeHash writes v into h, recursing into composite values up to maxHashDepth.
+// With identity set, pointers, channels and unsafe pointers are written as addresses instead of being followed.
+//
+//nolint:gocognit
+func writeHash(h *maphash.Hash, v reflect.Value, depth int, identity bool) {
+	if depth > maxHashDepth {
+		return
+	}
+	switch v.Kind() {
+	case reflect.Invalid:
+		h.WriteByte(0)
+	case reflect.Bool:
+		if v.Bool() {
+			h.WriteByte(1)
+		} else {
+			h.WriteByte(2)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		writeUint64(h, uint64(v.Int()))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		writeUint64(h, v.Uint())
+	case reflect.Float32, reflect.Float64:
+		writeFloat(h, v.Float())
+	case reflect.Complex64, reflect.Complex128:
+		c := v.Complex()
+		writeFloat(h, real(c))
+		writeFloat(h, imag(c))
+	case reflect.String:
+		h.WriteString(v.String())
+	case reflect.Pointer, reflect.Chan, reflect.UnsafePointer:
+		if identity {
+			writeUint64(h, uint64(v.Pointer()))
+			return
+		}
+		if v.Kind() != reflect.Pointer {
+			h.WriteByte(byte(v.Kind())) // Only pointers are followed: see the default case
+			return
+		}
+		if v.IsNil() {
+			h.WriteByte(0)
+			return
+		}
+		writeHash(h, v.Elem(), depth+1, identity)
+	case reflect.Interface:
+		if v.IsNil() {
+			h.WriteByte(0)
+			return
+		}
+		writeHash(h, v.Elem(), depth+1, identity)
+	case reflect.Array, reflect.Slice:
+		writeUint64(h, uint64(v.Len()))
+		for i := 0; i < v.Len(); i++ {
+			writeHash(h, v.Index(i), depth+1, identity)
+		}
+	case reflect.Map:
+		writeUint64(h, uint64(v.Len())) // Iteration order is random: length only
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			writeHash(h, v.Field(i), depth+1, identity)
+		}
+	default:
+		// Func (and Chan, UnsafePointer without identity): deep equality is not identity,
+		// so addresses must not leak into the hash
+		h.WriteByte(byte(v.Kind()))
+	}
+}
+
+func writeUint64(h *maphash.Hash, x uint64) {
+	var buf [8]byte
+	for i := range buf {
+		buf[i] = byte(x >> (8 * i))
+	}
+	h.Write(buf[:])
+}
+
+func writeFloat(h *maphash.Hash, f float64) {
+	if f == 0 {
+		f = 0 // -0 == +0
+	}
+	writeUint64(h, math.Float64bits(f))
+}
+
+// keyIndex assigns dense indexes, in insertion order, to distinct keys.
+type keyIndex[K any] interface {
+	// find returns the index of key and true, or -1 and false if key was never added.
+	find(key K) (int, bool)
+	// add returns the index of a newly added key and true,
+	// or the index of an equal key added earlier and false.
+	add(key K) (int, bool)
+}
+
+// mapIndex is a keyIndex for comparable keys backed by a Go map.
+type mapIndex[K comparable] map[K]int
+
+func newMapIndex[K comparable]() keyIndex[K] {
+	return make(mapIndex[K])
+}
+
+func (m mapIndex[K]) find(key K) (int, bool) {
+	if i, ok := m[key]; ok {
+		return i, true
+	}
+	return -1, false
+}
+
+func (m mapIndex[K]) add(key K) (int, bool) {
+	if i, ok := m[key]; ok {
+		return i, false
+	}
+	i := len(m)
+	m[key] = i
+	return i, true
+}
+
+// hashIndex is a keyIndex for keys compared by an EqualityComparer.
+type hashIndex[K any] struct {
+	comparer EqualityComparer[K]
+	buckets  map[uint64][]int // Hash -> indexes of keys with that hash
+	keys     []K              // Keys in insertion order
+}
+
+// newHashIndex returns a keyIndex for comparer, backed by a Go map for DefaultComparer.
+func newHashIndex[K any](comparer EqualityComparer[K]) keyIndex[K] {
+	if c, ok := comparer.(interface{ newIndex() keyIndex[K] }); ok {
+		return c.newIndex()
+	}
+	return &hashIndex[K]{
+		comparer: comparer,
+		buckets:  make(map[uint64][]int),
+	}
+}
+
+func (h *hashIndex[K]) find(key K) (int, bool) {
+	return h.lookup(key, h.comparer.Hash(key))
+}
+
+func (h *hashIndex[K]) add(key K) (int, bool) {
+	hash := h.comparer.Hash(key)
+	if i, ok := h.lookup(key, hash); ok {
+		return i, false
+	}
+	i := len(h.keys)
+	h.keys = append(h.keys, key)
+	h.buckets[hash] = append(h.buckets[hash], i)
+	return i, true
+}
+
+func (h *hashIndex[K]) lookup(key K, hash uint64) (int, bool) {
+	for _, i := range h.buckets[hash] {
+		if h.comparer.Equals(h.keys[i], key) {
+			return i, true
+		}
+	}
+	return -1, false
+}
@@ -0,0 +1,112 @@
+package glinq
+
+import (
+	"math"
+	"testing"
+)
+
+// assertConsistent checks that values equal under comparer have equal hashes
+func assertConsistent[T any](t *testing.T, name string, comparer EqualityComparer[T], a, b T) {
+	t.Helper()
+	if !comparer.Equals(a, b) {
+		t.Errorf("%s: expected %v and %v to be equal", name, a, b)
+	}
+	if comparer.Hash(a) != comparer.Hash(b) {
+		t.Errorf("%s: expected equal hashes for %v and %v", name, a, b)
+	}
+}
+
+type hashNode struct {
+	Value int
+	Next  *hashNode
+}
+
+func TestDefaultComparer(t *testing.T) {
+	c := DefaultComparer[int]()
+	assertConsistent(t, "int", c, 7, 7)
+	if c.Equals(1, 2) {
+		t.Error("Expected 1 and 2 to differ")
+	}
+
+	shared := &hashNode{Value: 1}
+	assertConsistent(t, "pointer", DefaultComparer[*hashNode](), shared, shared)
+	assertConsistent(t, "negative zero", DefaultComparer[float64](), 0.0, math.Copysign(0, -1))
+
+	t.Run("Mutated pointee keeps its hash", func(t *testing.T) {
+		pointers := DefaultComparer[*hashNode]()
+		interfaces := DefaultComparer[any]()
+		before, beforeAny := pointers.Hash(shared), interfaces.Hash(shared)
+		shared.Value++
+		if pointers.Hash(shared) != before || interfaces.Hash(shared) != beforeAny {
+			t.Error("Expected the hash of a pointer to ignore its pointee")
+		}
+	})
+
+	t.Run("DistinctWith matches Distinct", func(t *testing.T) {
+		n := &hashNode{}
+		mutate := func(p *hashNode) *hashNode {
+			p.Value++
+			return p
+		}
+		nodes := []*hashNode{n, n, n}
+
+		result := DistinctWith(Select(From(nodes), mutate), DefaultComparer[*hashNode]()).ToSlice()
+		if len(result) != 1 || result[0] != n {
+			t.Errorf("Expected [%p], got %v", n, result)
+		}
+		if expected := Distinct(Select(From(nodes), mutate)).ToSlice(); len(expected) != len(result) {
+			t.Errorf("Expected DistinctWith to match Distinct %v, got %v", expected, result)
+		}
+	})
+}
+
+func TestDeepEqualComparer(t *testing.T) {
+	assertConsistent(t, "slices", DeepEqualComparer[[]int](), []int{1, 2}, []int{1, 2})
+	assertConsistent(t, "maps", DeepEqualComparer[map[string]int](),
+		map[string]int{"a": 1, "b": 2}, map[string]int{"b": 2, "a": 1})
+	assertConsistent(t, "pointers", DeepEqualComparer[*hashNode](),
+		&hashNode{Value: 1, Next: &hashNode{Value: 2}}, &hashNode{Value: 1, Next: &hashNode{Value: 2}})
+	assertConsistent(t, "interfaces", DeepEqualComparer[any](), any([]string{"x"}), any([]string{"x"}))
+
+	if DeepEqualComparer[[]int]().Equals([]int{1}, []int{2}) {
+		t.Error("Expected [1] and [2] to differ")
+	}
+
+	cyclic := &hashNode{Value: 1}
+	cyclic.Next = cyclic
+	other := &hashNode{Value: 1}
+	other.Next = other
+	assertConsistent(t, "cyclic", DeepEqualComparer[*hashNode](), cyclic, other)
+}
+
+func TestStringFoldComparer(t *testing.T) {
+	c := StringFoldComparer()
+	assertConsistent(t, "ascii", c, "Hello", "hELLO")
+	assertConsistent(t, "kelvin sign", c, "K", "k")
+	assertConsistent(t, "greek sigma", c, "ΣΑΣ", "σας")
+	if c.Equals("go", "gopher") {
+		t.Error("Expected go and gopher to differ")
+	}
+}
+
+func TestFloatComparer(t *testing.T) {
+	c := FloatComparer(0.01)
+	assertConsistent(t, "within epsilon", c, 1.0, 1.005)
+	if c.Equals(1.0, 1.1) {
+		t.Error("Expected 1.0 and 1.1 to differ")
+	}
+	if !c.Equals(math.Inf(1), math.Inf(1)) {
+		t.Error("Expected +Inf to equal itself")
+	}
+}
+
+func TestNewComparer(t *testing.T) {
+	mod10 := NewComparer(
+		func(a, b int) bool { return a%10 == b%10 },
+		func(x int) uint64 { return uint64(x % 10) },
+	)
+	assertConsistent(t, "mod 10", mod10, 3, 13)
+
+	result := DistinctWith(From([]int{1, 11, 2, 21, 12}), mod10)
+	assertReiterable(t, "DistinctWith", result, []int{1, 2})
+}
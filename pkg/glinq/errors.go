@@ -0,0 +1,35 @@
+package glinq
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrNoElements is returned when an operation requires at least one element but the Stream is empty.
+	ErrNoElements = errors.New("glinq: sequence contains no elements")
+	// ErrMoreThanOneElement is returned when an operation requires exactly one element
+	// but the Stream contains more.
+	ErrMoreThanOneElement = errors.New("glinq: sequence contains more than one element")
+	// ErrLengthMismatch is returned when sequences that must have the same length do not.
+	ErrLengthMismatch = errors.New("glinq: sequences have different lengths")
+	// ErrDuplicateKey is returned when a key that must be unique appears more than once.
+	// The error returned is a *DuplicateKeyError carrying the key.
+	ErrDuplicateKey = errors.New("glinq: duplicate key")
+)
+
+// DuplicateKeyError reports the first key that appeared more than once.
+// It unwraps to ErrDuplicateKey, so errors.Is(err, ErrDuplicateKey) reports true.
+type DuplicateKeyError[K comparable] struct {
+	Key K
+}
+
+// Error implements error.
+func (e *DuplicateKeyError[K]) Error() string {
+	return fmt.Sprintf("%v: %v", ErrDuplicateKey, e.Key)
+}
+
+// Unwrap returns ErrDuplicateKey.
+func (e *DuplicateKeyError[K]) Unwrap() error {
+	return ErrDuplicateKey
+}
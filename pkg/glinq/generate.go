@@ -0,0 +1,177 @@
+package glinq
+
+// Repeat creates a Stream that contains value repeated count times.
+// If count is negative, returns an empty Stream.
+//
+// SIZE: Known (count).
+//
+// Example:
+//
+//	dashes := Repeat("-", 3).ToSlice()
+//	// ["-", "-", "-"]
+func Repeat[T any](value T, count int) Stream[T] {
+	if count < 0 {
+		return Empty[T]()
+	}
+
+	return &stream[T]{
+		sourceFactory: func(sc *scope) func() (T, bool) {
+			index := 0 // Fresh index for each iterator
+			return func() (T, bool) {
+				if index >= count {
+					var zero T
+					return zero, false
+				}
+				index++
+				return value, true
+			}
+		},
+		size: count,
+		at:   func(int) T { return value },
+	}
+}
+
+// RepeatForever creates an infinite Stream that contains value repeated endlessly.
+// Bound it with Take or TakeWhile before calling a terminal operation that reads everything.
+//
+// SIZE: Unknown (infinite).
+//
+// Example:
+//
+//	zeros := RepeatForever(0).Take(4).ToSlice()
+//	// [0, 0, 0, 0]
+func RepeatForever[T any](value T) Stream[T] {
+	return &stream[T]{
+		sourceFactory: func(sc *scope) func() (T, bool) {
+			return func() (T, bool) {
+				return value, true
+			}
+		},
+		size: -1, // UNKNOWN: infinite
+	}
+}
+
+// Generate creates an infinite Stream whose elements are produced by calling generator.
+// Bound it with Take or TakeWhile before calling a terminal operation that reads everything.
+//
+// SIZE: Unknown (infinite).
+//
+// Example:
+//
+//	ids := Generate(newID).Take(3).ToSlice()
+func Generate[T any](generator func() T) Stream[T] {
+	return &stream[T]{
+		sourceFactory: func(sc *scope) func() (T, bool) {
+			return func() (T, bool) {
+				return generator(), true
+			}
+		},
+		size: -1, // UNKNOWN: infinite
+	}
+}
+
+// Iterate creates an infinite Stream of seed, next(seed), next(next(seed)), ...
+// Bound it with Take or TakeWhile before calling a terminal operation that reads everything.
+//
+// SIZE: Unknown (infinite).
+//
+// Example:
+//
+//	powers := Iterate(1, func(x int) int { return x * 2 }).Take(5).ToSlice()
+//	// [1, 2, 4, 8, 16]
+func Iterate[T any](seed T, next func(T) T) Stream[T] {
+	return &stream[T]{
+		sourceFactory: func(sc *scope) func() (T, bool) {
+			current := seed // Fresh state for each iterator
+			started := false
+			return func() (T, bool) {
+				if started {
+					current = next(current)
+				}
+				started = true
+				return current, true
+			}
+		},
+		size: -1, // UNKNOWN: infinite
+	}
+}
+
+// Unfold creates a Stream from a seed state and a step function.
+// step returns the next element, the next state and true, or false to end the Stream.
+//
+// SIZE: Unknown (ends when step returns false).
+//
+// Example:
+//
+//	fib := Unfold([2]int{0, 1}, func(s [2]int) (int, [2]int, bool) {
+//	    return s[0], [2]int{s[1], s[0] + s[1]}, true
+//	}).Take(7).ToSlice()
+//	// [0, 1, 1, 2, 3, 5, 8]
+func Unfold[T, S any](seed S, step func(S) (T, S, bool)) Stream[T] {
+	return &stream[T]{
+		sourceFactory: func(sc *scope) func() (T, bool) {
+			state := seed // Fresh state for each iterator
+			done := false
+			return func() (T, bool) {
+				var zero T
+				if done {
+					return zero, false
+				}
+				value, nextState, ok := step(state)
+				if !ok {
+					done = true
+					return zero, false
+				}
+				state = nextState
+				return value, true
+			}
+		},
+		size: -1, // UNKNOWN: ends when step returns false
+	}
+}
+
+// Cycle creates an infinite Stream that repeats the elements of enum endlessly.
+// The first pass is buffered and replayed, so enum is read only once per iteration.
+// If enum is empty, the result is empty.
+// Bound it with Take or TakeWhile before calling a terminal operation that reads everything.
+//
+// SIZE: Known (0) for an empty source of known size, otherwise unknown (infinite).
+//
+// Example:
+//
+//	colors := Cycle(From([]string{"red", "green"})).Take(5).ToSlice()
+//	// ["red", "green", "red", "green", "red"]
+func Cycle[T any](enum Enumerable[T]) Stream[T] {
+	if sizeOf(enum) == 0 {
+		return Empty[T]()
+	}
+
+	return &stream[T]{
+		sourceFactory: func(sc *scope) func() (T, bool) {
+			source := iteratorOf(enum, sc) // Get fresh source
+			var buffer []T
+			replaying := false
+			index := 0
+
+			return func() (T, bool) {
+				if !replaying {
+					value, ok := source()
+					if ok {
+						buffer = append(buffer, value)
+						return value, true
+					}
+					replaying = true
+				}
+				if len(buffer) == 0 {
+					var zero T
+					return zero, false
+				}
+				result := buffer[index]
+				index = (index + 1) % len(buffer)
+				return result, true
+			}
+		},
+		size:    -1, // UNKNOWN: infinite
+		oneShot: isOneShot(enum),
+	}
+}
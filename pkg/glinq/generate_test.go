@@ -0,0 +1,118 @@
+package glinq
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRepeat(t *testing.T) {
+	s := Repeat("-", 3)
+	assertReiterable(t, "Repeat", s, []string{"-", "-", "-"})
+	if size, ok := s.Size(); !ok || size != 3 {
+		t.Errorf("Expected known size 3, got %d (known=%v)", size, ok)
+	}
+
+	if result := Repeat(1, -1).ToSlice(); len(result) != 0 {
+		t.Errorf("Expected empty stream for negative count, got %v", result)
+	}
+}
+
+func TestRepeatForever(t *testing.T) {
+	s := RepeatForever(7)
+	assertNoSize(t, s, "RepeatForever")
+
+	result := s.Take(3).ToSlice()
+	if expected := []int{7, 7, 7}; !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+	assertNoSize(t, s.Take(3), "RepeatForever.Take")
+	if n := s.Take(3).Count(); n != 3 {
+		t.Errorf("Expected Take(3).Count() == 3, got %d", n)
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	counter := 0
+	s := Generate(func() int {
+		counter++
+		return counter
+	})
+	assertNoSize(t, s, "Generate")
+
+	result := s.Take(4).ToSlice()
+	if expected := []int{1, 2, 3, 4}; !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+	if counter != 4 {
+		t.Errorf("Expected generator to be called 4 times, got %d", counter)
+	}
+}
+
+func TestIterate(t *testing.T) {
+	s := Iterate(1, func(x int) int { return x * 2 })
+	assertNoSize(t, s, "Iterate")
+
+	result := s.Take(5).ToSlice()
+	if expected := []int{1, 2, 4, 8, 16}; !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+
+	bounded := s.TakeWhile(func(x int) bool { return x < 100 })
+	assertReiterable(t, "Iterate.TakeWhile", bounded, []int{1, 2, 4, 8, 16, 32, 64})
+}
+
+func TestUnfold(t *testing.T) {
+	fib := Unfold([2]int{0, 1}, func(s [2]int) (int, [2]int, bool) {
+		return s[0], [2]int{s[1], s[0] + s[1]}, true
+	})
+	result := fib.Take(7).ToSlice()
+	if expected := []int{0, 1, 1, 2, 3, 5, 8}; !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+
+	countdown := Unfold(3, func(n int) (int, int, bool) {
+		return n, n - 1, n > 0
+	})
+	assertNoSize(t, countdown, "Unfold")
+	assertReiterable(t, "Unfold", countdown, []int{3, 2, 1})
+
+	// Take(n) of a finite source of unknown size must not report n elements
+	if n := countdown.Take(10).Count(); n != 3 {
+		t.Errorf("Expected Take(10).Count() == 3, got %d", n)
+	}
+	if countdown.Take(0).Any() {
+		t.Error("Expected Take(0) to be empty")
+	}
+}
+
+func TestCycle(t *testing.T) {
+	s := Cycle(From([]string{"red", "green"}))
+	assertNoSize(t, Cycle(From([]int{1})), "Cycle")
+
+	result := s.Take(5).ToSlice()
+	if expected := []string{"red", "green", "red", "green", "red"}; !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+
+	t.Run("Source read once per iteration", func(t *testing.T) {
+		pulled := 0
+		source := From([]int{1, 2}).Select(func(x int) int {
+			pulled++
+			return x
+		})
+		Cycle(source).Take(7).ToSlice()
+		if pulled != 2 {
+			t.Errorf("Expected 2 source pulls, got %d", pulled)
+		}
+	})
+
+	t.Run("Empty source", func(t *testing.T) {
+		if result := Cycle(Empty[int]()).Take(3).ToSlice(); len(result) != 0 {
+			t.Errorf("Expected empty result, got %v", result)
+		}
+		empty := Cycle(From([]int{1}).Where(func(int) bool { return false }))
+		if result := empty.Take(3).ToSlice(); len(result) != 0 {
+			t.Errorf("Expected empty result, got %v", result)
+		}
+	})
+}
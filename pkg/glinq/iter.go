@@ -0,0 +1,129 @@
+package glinq
+
+import "iter"
+
+// Seq returns the Stream as a Go 1.23 range-over-func iterator.
+// Each call to the returned iter.Seq starts a fresh iteration over the Stream.
+//
+// Example:
+//
+//	for x := range From([]int{1, 2, 3}).Where(isOdd).Seq() {
+//	    fmt.Println(x)
+//	}
+//
+//	doubled := slices.Collect(From([]int{1, 2, 3}).Select(double).Seq())
+func (s *stream[T]) Seq() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		iterator, sc := s.open() // Fresh iterator
+		defer sc.close()
+		for {
+			value, ok := iterator()
+			if !ok {
+				return
+			}
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+// ToSeq converts any Enumerable into an iter.Seq.
+// If enum is a Stream or Restartable, every range over the result starts a fresh iteration.
+// Other Enumerables are consumed through Next and can be ranged over only once.
+func ToSeq[T any](enum Enumerable[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		iterator, sc := openEnumerable(enum) // Fresh iterator if possible
+		defer sc.close()
+		for {
+			value, ok := iterator()
+			if !ok {
+				return
+			}
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+// ToSeq2 converts an Enumerable of KeyValue pairs into an iter.Seq2.
+// This allows KeyValue streams to be consumed with maps.Collect or a two-value range loop.
+//
+// Example:
+//
+//	m := maps.Collect(ToSeq2(FromMap(source)))
+//
+//	for k, v := range ToSeq2(FromMap(source)) {
+//	    fmt.Println(k, v)
+//	}
+func ToSeq2[K comparable, V any](enum Enumerable[KeyValue[K, V]]) iter.Seq2[K, V] {
+	seq := ToSeq(enum)
+	return func(yield func(K, V) bool) {
+		for kv := range seq {
+			if !yield(kv.Key, kv.Value) {
+				return
+			}
+		}
+	}
+}
+
+// FromSeq creates a Stream from an iter.Seq.
+// Each iteration of the Stream pulls from a fresh run of seq, so the Stream is
+// re-iterable whenever seq itself is (e.g. slices.Values, maps.Keys). An iter.Seq cannot tell
+// whether it is single-use, so operators that need a second pass (Unzip) buffer the Stream.
+//
+// SIZE: Unknown (iter.Seq carries no size information).
+//
+// NOTE: The sequence is consumed with iter.Pull. The pull iterator is stopped when the
+// sequence is exhausted or the terminal operation ends early, so deferred cleanup in seq runs.
+// A Stream consumed through Next holds its pull iterator until it is exhausted or Close is called.
+//
+// Example:
+//
+//	evens := FromSeq(slices.Values([]int{1, 2, 3, 4})).
+//	    Where(func(x int) bool { return x%2 == 0 }).
+//	    ToSlice()
+//	// [2, 4]
+func FromSeq[T any](seq iter.Seq[T]) Stream[T] {
+	return &stream[T]{
+		sourceFactory: func(sc *scope) func() (T, bool) {
+			next, stop := iter.Pull(seq) // Fresh pull iterator
+			sc.onClose(stop)
+			done := false
+			return func() (T, bool) {
+				if done {
+					var zero T
+					return zero, false
+				}
+				value, ok := next()
+				if !ok {
+					done = true
+					stop()
+				}
+				return value, ok
+			}
+		},
+		size:    -1, // UNKNOWN: iter.Seq has no size
+		oneShot: true,
+	}
+}
+
+// FromSeq2 creates a Stream of KeyValue pairs from an iter.Seq2.
+// Each iteration of the Stream pulls from a fresh run of seq.
+//
+// SIZE: Unknown (iter.Seq2 carries no size information).
+//
+// Example:
+//
+//	pairs := FromSeq2(maps.All(map[string]int{"a": 1})).ToSlice()
+//	// []KeyValue[string, int]{{Key: "a", Value: 1}}
+func FromSeq2[K comparable, V any](seq iter.Seq2[K, V]) Stream[KeyValue[K, V]] {
+	return FromSeq(func(yield func(KeyValue[K, V]) bool) {
+		for k, v := range seq {
+			if !yield(KeyValue[K, V]{Key: k, Value: v}) {
+				return
+			}
+		}
+	})
+}
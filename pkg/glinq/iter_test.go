@@ -0,0 +1,177 @@
+package glinq
+
+import (
+	"maps"
+	"reflect"
+	"runtime"
+	"slices"
+	"testing"
+)
+
+func TestSeq(t *testing.T) {
+	t.Run("Range over stream", func(t *testing.T) {
+		var result []int
+		for x := range From([]int{1, 2, 3, 4}).Where(func(x int) bool { return x%2 == 0 }).Seq() {
+			result = append(result, x)
+		}
+
+		expected := []int{2, 4}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("Expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("slices.Collect", func(t *testing.T) {
+		result := slices.Collect(Range(1, 3).Select(func(x int) int { return x * 10 }).Seq())
+
+		expected := []int{10, 20, 30}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("Expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("Break stops iteration", func(t *testing.T) {
+		pulled := 0
+		s := From([]int{1, 2, 3, 4, 5}).Select(func(x int) int {
+			pulled++
+			return x
+		})
+		for x := range s.Seq() {
+			if x == 2 {
+				break
+			}
+		}
+		if pulled != 2 {
+			t.Errorf("Expected 2 elements pulled, got %d", pulled)
+		}
+	})
+
+	t.Run("Seq is re-iterable", func(t *testing.T) {
+		seq := From([]int{1, 2, 3}).Seq()
+		first := slices.Collect(seq)
+		second := slices.Collect(seq)
+		if !reflect.DeepEqual(first, second) {
+			t.Errorf("Expected equal iterations, got %v and %v", first, second)
+		}
+	})
+}
+
+func TestToSeq(t *testing.T) {
+	result := slices.Collect(ToSeq[int](From([]int{1, 2, 3})))
+
+	expected := []int{1, 2, 3}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+}
+
+func TestToSeq2(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2, "c": 3}
+	result := maps.Collect(ToSeq2(FromMap(m)))
+
+	if !reflect.DeepEqual(result, m) {
+		t.Errorf("Expected %v, got %v", m, result)
+	}
+}
+
+func TestFromSeq(t *testing.T) {
+	t.Run("From slices.Values", func(t *testing.T) {
+		result := FromSeq(slices.Values([]int{1, 2, 3, 4})).
+			Where(func(x int) bool { return x > 2 }).
+			ToSlice()
+
+		expected := []int{3, 4}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("Expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("Fresh iteration per terminal", func(t *testing.T) {
+		s := FromSeq(slices.Values([]int{1, 2, 3}))
+		if s.Count() != 3 {
+			t.Errorf("Expected count 3")
+		}
+		if s.Count() != 3 {
+			t.Errorf("Expected count 3 on second iteration")
+		}
+		first, ok := s.First()
+		if !ok || first != 1 {
+			t.Errorf("Expected first 1, got %d, ok=%v", first, ok)
+		}
+	})
+
+	t.Run("Unknown size", func(t *testing.T) {
+		if _, ok := FromSeq(slices.Values([]int{1})).Size(); ok {
+			t.Error("Expected unknown size")
+		}
+		if FromSeq(slices.Values([]int{})).Take(3).Any() {
+			t.Error("Expected Take of an empty sequence to be empty")
+		}
+	})
+
+	t.Run("Round trip", func(t *testing.T) {
+		result := slices.Collect(FromSeq(From([]int{5, 6}).Seq()).Seq())
+
+		expected := []int{5, 6}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("Expected %v, got %v", expected, result)
+		}
+	})
+}
+
+func TestFromSeq2(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2}
+	result := ToMap(FromSeq2(maps.All(m)))
+
+	if !reflect.DeepEqual(result, m) {
+		t.Errorf("Expected %v, got %v", m, result)
+	}
+}
+
+func TestFromSeqCleanup(t *testing.T) {
+	cleanups := 0
+	seq := func(yield func(int) bool) {
+		defer func() { cleanups++ }()
+		for i := 1; i <= 5; i++ {
+			if !yield(i) {
+				return
+			}
+		}
+	}
+
+	if x, ok := FromSeq(seq).Where(func(x int) bool { return x > 1 }).First(); !ok || x != 2 {
+		t.Errorf("Expected 2, got %d (%v)", x, ok)
+	}
+	if cleanups != 1 {
+		t.Errorf("Expected seq cleanup to run once after early exit, got %d", cleanups)
+	}
+
+	FromSeq(seq).ToSlice()
+	if cleanups != 2 {
+		t.Errorf("Expected seq cleanup to run once after exhaustion, got %d", cleanups)
+	}
+}
+
+func TestFromSeqNoGoroutineLeak(t *testing.T) {
+	naturals := func(yield func(int) bool) {
+		for i := 0; ; i++ {
+			if !yield(i) {
+				return
+			}
+		}
+	}
+	s := FromSeq(naturals)
+
+	before := runtime.NumGoroutine()
+	for i := 0; i < 100; i++ {
+		s.First()
+		s.Take(3).ToSlice()
+		s.AnyMatch(func(x int) bool { return x == 5 })
+		for range s.Seq() {
+			break
+		}
+	}
+	if after := runtime.NumGoroutine(); after > before+5 {
+		t.Errorf("Expected abandoned pull iterators to be stopped, goroutines grew from %d to %d", before, after)
+	}
+}
@@ -0,0 +1,382 @@
+package glinq
+
+import "slices"
+
+// Join correlates the elements of two Enumerables based on matching keys (inner join).
+// For each outer element, resultSelector is called once per inner element with an equal key,
+// in outer order and then inner order. Outer elements without a match are dropped.
+// This is a function (not a method) because in Go methods cannot have their own type parameters.
+//
+// LAZY: The inner hash table is built on the first Next() of each iteration.
+//
+// SIZE: Loses size (unknown number of matches).
+//
+// Example:
+//
+//	type User struct { ID int; Name string }
+//	type Order struct { UserID int; Item string }
+//	rows := Join(
+//	    From(users), From(orders),
+//	    func(u User) int { return u.ID },
+//	    func(o Order) int { return o.UserID },
+//	    func(u User, o Order) string { return u.Name + ": " + o.Item },
+//	).ToSlice()
+func Join[TOuter, TInner any, K comparable, R any](
+	outer Enumerable[TOuter],
+	inner Enumerable[TInner],
+	outerKey func(TOuter) K,
+	innerKey func(TInner) K,
+	resultSelector func(TOuter, TInner) R,
+) Stream[R] {
+	return hashJoin(outer, inner, outerKey, innerKey, newMapIndex[K], -1,
+		joinProjection[TOuter, TInner](resultSelector),
+		nil,
+	)
+}
+
+// JoinWith correlates the elements of two Enumerables like Join, using comparer to decide key equality.
+// Keys do not need to be comparable.
+// This is a function (not a method) because in Go methods cannot have their own type parameters.
+//
+// LAZY: The inner hash table is built on the first Next() of each iteration.
+//
+// SIZE: Loses size (unknown number of matches).
+//
+// Example:
+//
+//	rows := JoinWith(
+//	    From(users), From(emails),
+//	    func(u User) string { return u.Email },
+//	    func(e Email) string { return e.Address },
+//	    func(u User, e Email) string { return u.Name + ": " + e.Subject },
+//	    StringFoldComparer(),
+//	).ToSlice()
+func JoinWith[TOuter, TInner, K, R any](
+	outer Enumerable[TOuter],
+	inner Enumerable[TInner],
+	outerKey func(TOuter) K,
+	innerKey func(TInner) K,
+	resultSelector func(TOuter, TInner) R,
+	comparer EqualityComparer[K],
+) Stream[R] {
+	newIndex := func() keyIndex[K] { return newHashIndex(comparer) }
+	return hashJoin(outer, inner, outerKey, innerKey, newIndex, -1,
+		joinProjection[TOuter, TInner](resultSelector),
+		nil,
+	)
+}
+
+// joinProjection projects an outer element and its matches as an inner join does:
+// one result per match, nothing for an unmatched outer element.
+func joinProjection[TOuter, TInner, R any](resultSelector func(TOuter, TInner) R) func(TOuter, []TInner, []R) []R {
+	return func(o TOuter, matches []TInner, pending []R) []R {
+		for _, i := range matches {
+			pending = append(pending, resultSelector(o, i))
+		}
+		return pending
+	}
+}
+
+// GroupJoin correlates each outer element with the slice of all inner elements with an equal key.
+// resultSelector is called exactly once per outer element; the slice is empty when nothing matches.
+// Each call receives its own copy of the matches, so resultSelector may keep or modify it.
+// This is a function (not a method) because in Go methods cannot have their own type parameters.
+//
+// LAZY: The inner hash table is built on the first Next() of each iteration.
+//
+// SIZE: Preserves size of outer if known (one result per outer element).
+//
+// Example:
+//
+//	orderCounts := GroupJoin(
+//	    From(users), From(orders),
+//	    func(u User) int { return u.ID },
+//	    func(o Order) int { return o.UserID },
+//	    func(u User, os []Order) KeyValue[string, int] { return KeyValue[string, int]{Key: u.Name, Value: len(os)} },
+//	).ToSlice()
+func GroupJoin[TOuter, TInner any, K comparable, R any](
+	outer Enumerable[TOuter],
+	inner Enumerable[TInner],
+	outerKey func(TOuter) K,
+	innerKey func(TInner) K,
+	resultSelector func(TOuter, []TInner) R,
+) Stream[R] {
+	return hashJoin(outer, inner, outerKey, innerKey, newMapIndex[K], sizeOf(outer),
+		groupJoinProjection(resultSelector),
+		nil,
+	)
+}
+
+// GroupJoinWith correlates each outer element with its matching inner elements like GroupJoin,
+// using comparer to decide key equality. Keys do not need to be comparable.
+// This is a function (not a method) because in Go methods cannot have their own type parameters.
+//
+// LAZY: The inner hash table is built on the first Next() of each iteration.
+//
+// SIZE: Preserves size of outer if known (one result per outer element).
+//
+// Example:
+//
+//	inboxes := GroupJoinWith(
+//	    From(users), From(emails),
+//	    func(u User) string { return u.Email },
+//	    func(e Email) string { return e.Address },
+//	    func(u User, es []Email) KeyValue[string, int] { return KeyValue[string, int]{Key: u.Name, Value: len(es)} },
+//	    StringFoldComparer(),
+//	).ToSlice()
+func GroupJoinWith[TOuter, TInner, K, R any](
+	outer Enumerable[TOuter],
+	inner Enumerable[TInner],
+	outerKey func(TOuter) K,
+	innerKey func(TInner) K,
+	resultSelector func(TOuter, []TInner) R,
+	comparer EqualityComparer[K],
+) Stream[R] {
+	newIndex := func() keyIndex[K] { return newHashIndex(comparer) }
+	return hashJoin(outer, inner, outerKey, innerKey, newIndex, sizeOf(outer),
+		groupJoinProjection(resultSelector),
+		nil,
+	)
+}
+
+// groupJoinProjection projects an outer element and its matches as a group join does:
+// exactly one result, with a copy of the matches.
+func groupJoinProjection[TOuter, TInner, R any](resultSelector func(TOuter, []TInner) R) func(TOuter, []TInner, []R) []R {
+	return func(o TOuter, matches []TInner, pending []R) []R {
+		return append(pending, resultSelector(o, slices.Clone(matches))) // Don't share the inner group
+	}
+}
+
+// LeftJoin correlates two Enumerables like Join, but keeps outer elements without a match.
+// For unmatched outer elements resultSelector receives the zero value of TInner and matched == false.
+// This is a function (not a method) because in Go methods cannot have their own type parameters.
+//
+// LAZY: The inner hash table is built on the first Next() of each iteration.
+//
+// SIZE: Loses size (at least one result per outer element, unknown total).
+//
+// Example:
+//
+//	rows := LeftJoin(
+//	    From(users), From(orders),
+//	    func(u User) int { return u.ID },
+//	    func(o Order) int { return o.UserID },
+//	    func(u User, o Order, matched bool) string {
+//	        if !matched {
+//	            return u.Name + ": no orders"
+//	        }
+//	        return u.Name + ": " + o.Item
+//	    },
+//	).ToSlice()
+func LeftJoin[TOuter, TInner any, K comparable, R any](
+	outer Enumerable[TOuter],
+	inner Enumerable[TInner],
+	outerKey func(TOuter) K,
+	innerKey func(TInner) K,
+	resultSelector func(outer TOuter, inner TInner, matched bool) R,
+) Stream[R] {
+	return hashJoin(outer, inner, outerKey, innerKey, newMapIndex[K], -1,
+		leftJoinProjection(resultSelector),
+		nil,
+	)
+}
+
+// LeftJoinWith correlates two Enumerables like LeftJoin, using comparer to decide key equality.
+// Keys do not need to be comparable.
+// This is a function (not a method) because in Go methods cannot have their own type parameters.
+//
+// LAZY: The inner hash table is built on the first Next() of each iteration.
+//
+// SIZE: Loses size (at least one result per outer element, unknown total).
+func LeftJoinWith[TOuter, TInner, K, R any](
+	outer Enumerable[TOuter],
+	inner Enumerable[TInner],
+	outerKey func(TOuter) K,
+	innerKey func(TInner) K,
+	resultSelector func(outer TOuter, inner TInner, matched bool) R,
+	comparer EqualityComparer[K],
+) Stream[R] {
+	newIndex := func() keyIndex[K] { return newHashIndex(comparer) }
+	return hashJoin(outer, inner, outerKey, innerKey, newIndex, -1,
+		leftJoinProjection(resultSelector),
+		nil,
+	)
+}
+
+// leftJoinProjection projects an outer element and its matches as a left join does:
+// one result per match, or one result with matched == false if there is none.
+func leftJoinProjection[TOuter, TInner, R any](
+	resultSelector func(outer TOuter, inner TInner, matched bool) R,
+) func(TOuter, []TInner, []R) []R {
+	return func(o TOuter, matches []TInner, pending []R) []R {
+		if len(matches) == 0 {
+			var zero TInner
+			return append(pending, resultSelector(o, zero, false))
+		}
+		for _, i := range matches {
+			pending = append(pending, resultSelector(o, i, true))
+		}
+		return pending
+	}
+}
+
+// FullOuterJoin correlates two Enumerables and keeps unmatched elements from both sides.
+// Matched pairs and unmatched outer elements are emitted first, in outer order;
+// inner elements whose key matched no outer element follow, in inner order.
+// A missing side is passed as its zero value with the corresponding flag set to false.
+// This is a function (not a method) because in Go methods cannot have their own type parameters.
+//
+// LAZY: The inner hash table is built on the first Next() of each iteration.
+//
+// SIZE: Loses size (unknown number of matches).
+//
+// Example:
+//
+//	rows := FullOuterJoin(
+//	    From(before), From(after),
+//	    func(r Row) int { return r.ID },
+//	    func(r Row) int { return r.ID },
+//	    func(b Row, hasBefore bool, a Row, hasAfter bool) string { ... },
+//	).ToSlice()
+func FullOuterJoin[TOuter, TInner any, K comparable, R any](
+	outer Enumerable[TOuter],
+	inner Enumerable[TInner],
+	outerKey func(TOuter) K,
+	innerKey func(TInner) K,
+	resultSelector func(outer TOuter, hasOuter bool, inner TInner, hasInner bool) R,
+) Stream[R] {
+	return hashJoin(outer, inner, outerKey, innerKey, newMapIndex[K], -1,
+		leftJoinProjection(func(o TOuter, i TInner, matched bool) R {
+			return resultSelector(o, true, i, matched)
+		}),
+		func(i TInner) R {
+			var zero TOuter
+			return resultSelector(zero, false, i, true)
+		},
+	)
+}
+
+// FullOuterJoinWith correlates two Enumerables like FullOuterJoin, using comparer to decide key equality.
+// Keys do not need to be comparable.
+// This is a function (not a method) because in Go methods cannot have their own type parameters.
+//
+// LAZY: The inner hash table is built on the first Next() of each iteration.
+//
+// SIZE: Loses size (unknown number of matches).
+func FullOuterJoinWith[TOuter, TInner, K, R any](
+	outer Enumerable[TOuter],
+	inner Enumerable[TInner],
+	outerKey func(TOuter) K,
+	innerKey func(TInner) K,
+	resultSelector func(outer TOuter, hasOuter bool, inner TInner, hasInner bool) R,
+	comparer EqualityComparer[K],
+) Stream[R] {
+	newIndex := func() keyIndex[K] { return newHashIndex(comparer) }
+	return hashJoin(outer, inner, outerKey, innerKey, newIndex, -1,
+		leftJoinProjection(func(o TOuter, i TInner, matched bool) R {
+			return resultSelector(o, true, i, matched)
+		}),
+		func(i TInner) R {
+			var zero TOuter
+			return resultSelector(zero, false, i, true)
+		},
+	)
+}
+
+// hashJoin is the common implementation of the join operators.
+// newIndex creates the index that decides key equality.
+// project appends the results for one outer element and its matching inner elements to pending.
+// If unmatchedInner is not nil, inner elements whose key matched no outer element are
+// projected with it after the outer side is exhausted.
+//
+//nolint:gocognit
+func hashJoin[TOuter, TInner, K, R any](
+	outer Enumerable[TOuter],
+	inner Enumerable[TInner],
+	outerKey func(TOuter) K,
+	innerKey func(TInner) K,
+	newIndex func() keyIndex[K],
+	size int,
+	project func(o TOuter, matches []TInner, pending []R) []R,
+	unmatchedInner func(TInner) R,
+) Stream[R] {
+	return &stream[R]{
+		sourceFactory: func(sc *scope) func() (R, bool) {
+			outerSource := iteratorOf(outer, sc) // Get fresh source
+			var index keyIndex[K]
+			var groups [][]TInner   // Inner elements by key index
+			var innerItems []TInner // Only kept for unmatchedInner
+			var innerGroups []int   // Key index of each item in innerItems
+			var matched []bool      // Whether a key index matched an outer element
+
+			var pending []R
+			pendingIndex := 0
+			outerDone := false
+			tailIndex := 0
+
+			return func() (R, bool) {
+				if index == nil {
+					// Build the inner hash table on first Next()
+					index = newIndex()
+					innerSource := iteratorOf(inner, sc) // Fresh pass over inner
+					for {
+						item, ok := innerSource()
+						if !ok {
+							break
+						}
+						i, added := index.add(innerKey(item))
+						if added {
+							groups = append(groups, nil)
+						}
+						groups[i] = append(groups[i], item)
+						if unmatchedInner != nil {
+							innerItems = append(innerItems, item)
+							innerGroups = append(innerGroups, i)
+						}
+					}
+					if unmatchedInner != nil {
+						matched = make([]bool, len(groups))
+					}
+				}
+
+				for {
+					if pendingIndex < len(pending) {
+						result := pending[pendingIndex]
+						pendingIndex++
+						return result, true
+					}
+
+					if !outerDone {
+						o, ok := outerSource()
+						if ok {
+							var matches []TInner
+							if i, found := index.find(outerKey(o)); found {
+								matches = groups[i]
+								if matched != nil {
+									matched[i] = true
+								}
+							}
+							pending = project(o, matches, pending[:0])
+							pendingIndex = 0
+							continue
+						}
+						outerDone = true
+					}
+
+					for unmatchedInner != nil && tailIndex < len(innerItems) {
+						item, group := innerItems[tailIndex], innerGroups[tailIndex]
+						tailIndex++
+						if !matched[group] {
+							return unmatchedInner(item), true
+						}
+					}
+
+					var zero R
+					return zero, false
+				}
+			}
+		},
+		size:    size,
+		oneShot: isOneShot(outer) || isOneShot(inner),
+	}
+}
@@ -0,0 +1,202 @@
+package glinq
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+type joinUser struct {
+	ID   int
+	Name string
+}
+
+type joinOrder struct {
+	UserID int
+	Item   string
+}
+
+var (
+	joinUsers = []joinUser{{1, "Alice"}, {2, "Bob"}, {3, "Carol"}}
+	// Orders reference users 1 and 2, plus an orphan order for user 4
+	joinOrders = []joinOrder{{2, "pen"}, {1, "book"}, {2, "ink"}, {4, "lamp"}}
+)
+
+func userID(u joinUser) int     { return u.ID }
+func orderUser(o joinOrder) int { return o.UserID }
+
+func TestJoin(t *testing.T) {
+	result := Join(From(joinUsers), From(joinOrders), userID, orderUser,
+		func(u joinUser, o joinOrder) string { return u.Name + ":" + o.Item },
+	).ToSlice()
+
+	expected := []string{"Alice:book", "Bob:pen", "Bob:ink"}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+}
+
+func TestJoinLazy(t *testing.T) {
+	innerReads := 0
+	inner := From(joinOrders).Select(func(o joinOrder) joinOrder {
+		innerReads++
+		return o
+	})
+	joined := Join(From(joinUsers), inner, userID, orderUser,
+		func(u joinUser, o joinOrder) string { return o.Item })
+
+	if innerReads != 0 {
+		t.Errorf("Expected inner not to be read before iteration, got %d reads", innerReads)
+	}
+	joined.First()
+	if innerReads != len(joinOrders) {
+		t.Errorf("Expected inner to be read once on first Next, got %d reads", innerReads)
+	}
+}
+
+func TestGroupJoin(t *testing.T) {
+	joined := GroupJoin(From(joinUsers), From(joinOrders), userID, orderUser,
+		func(u joinUser, orders []joinOrder) string { return fmt.Sprintf("%s=%d", u.Name, len(orders)) },
+	)
+	assertSize(t, joined, 3, "GroupJoin")
+
+	result := joined.ToSlice()
+	expected := []string{"Alice=1", "Bob=2", "Carol=0"}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+}
+
+func TestGroupJoinMatchesAreCopies(t *testing.T) {
+	identity := func(x int) int { return x % 10 }
+	result := GroupJoin(From([]int{1, 1}), From([]int{1, 2, 11}), identity, identity,
+		func(_ int, matches []int) []int {
+			received := slices.Clone(matches)
+			matches[0] = 99 // Must not be seen by the next outer element with the same key
+			return received
+		},
+	).ToSlice()
+
+	expected := [][]int{{1, 11}, {1, 11}}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+}
+
+func TestLeftJoin(t *testing.T) {
+	result := LeftJoin(From(joinUsers), From(joinOrders), userID, orderUser,
+		func(u joinUser, o joinOrder, matched bool) string {
+			if !matched {
+				return u.Name + ":-"
+			}
+			return u.Name + ":" + o.Item
+		},
+	).ToSlice()
+
+	expected := []string{"Alice:book", "Bob:pen", "Bob:ink", "Carol:-"}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+}
+
+func TestFullOuterJoin(t *testing.T) {
+	result := FullOuterJoin(From(joinUsers), From(joinOrders), userID, orderUser,
+		func(u joinUser, hasUser bool, o joinOrder, hasOrder bool) string {
+			switch {
+			case !hasUser:
+				return fmt.Sprintf("?:%s", o.Item)
+			case !hasOrder:
+				return u.Name + ":-"
+			default:
+				return u.Name + ":" + o.Item
+			}
+		},
+	).ToSlice()
+
+	expected := []string{"Alice:book", "Bob:pen", "Bob:ink", "Carol:-", "?:lamp"}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+}
+
+func TestJoinEmpty(t *testing.T) {
+	result := Join(Empty[joinUser](), From(joinOrders), userID, orderUser,
+		func(u joinUser, o joinOrder) string { return o.Item },
+	).ToSlice()
+	if len(result) != 0 {
+		t.Errorf("Expected empty result, got %v", result)
+	}
+
+	tail := FullOuterJoin(Empty[joinUser](), From(joinOrders[:1]), userID, orderUser,
+		func(u joinUser, hasUser bool, o joinOrder, hasOrder bool) bool { return !hasUser && hasOrder },
+	).ToSlice()
+	if !reflect.DeepEqual(tail, []bool{true}) {
+		t.Errorf("Expected unmatched inner element, got %v", tail)
+	}
+}
+
+func TestJoinWith(t *testing.T) {
+	type account struct{ Email string }
+	type message struct{ To, Subject string }
+
+	accounts := From([]account{{"Alice@Example.com"}, {"bob@example.com"}})
+	messages := From([]message{{"alice@example.COM", "hi"}, {"BOB@example.com", "yo"}, {"eve@example.com", "?"}})
+
+	result := JoinWith(accounts, messages,
+		func(a account) string { return a.Email },
+		func(m message) string { return m.To },
+		func(a account, m message) string { return a.Email + ":" + m.Subject },
+		StringFoldComparer(),
+	)
+
+	assertReiterable(t, "JoinWith", result, []string{"Alice@Example.com:hi", "bob@example.com:yo"})
+}
+
+func TestOuterJoinsWith(t *testing.T) {
+	type account struct{ Email string }
+	type message struct{ To, Subject string }
+
+	accounts := From([]account{{"Alice@Example.com"}, {"carol@example.com"}})
+	messages := From([]message{{"alice@example.COM", "hi"}, {"ALICE@example.com", "yo"}, {"eve@example.com", "?"}})
+	email := func(a account) string { return a.Email }
+	to := func(m message) string { return m.To }
+
+	t.Run("GroupJoinWith", func(t *testing.T) {
+		result := GroupJoinWith(accounts, messages, email, to,
+			func(a account, ms []message) string { return fmt.Sprintf("%s=%d", a.Email, len(ms)) },
+			StringFoldComparer(),
+		)
+		assertReiterable(t, "GroupJoinWith", result, []string{"Alice@Example.com=2", "carol@example.com=0"})
+		assertSize(t, result, 2, "GroupJoinWith")
+	})
+
+	t.Run("LeftJoinWith", func(t *testing.T) {
+		result := LeftJoinWith(accounts, messages, email, to,
+			func(a account, m message, matched bool) string {
+				if !matched {
+					return a.Email + ":-"
+				}
+				return a.Email + ":" + m.Subject
+			},
+			StringFoldComparer(),
+		)
+		assertReiterable(t, "LeftJoinWith", result,
+			[]string{"Alice@Example.com:hi", "Alice@Example.com:yo", "carol@example.com:-"})
+	})
+
+	t.Run("FullOuterJoinWith", func(t *testing.T) {
+		result := FullOuterJoinWith(accounts, messages, email, to,
+			func(a account, hasAccount bool, m message, hasMessage bool) string {
+				return fmt.Sprintf("%s/%v|%s/%v", a.Email, hasAccount, m.Subject, hasMessage)
+			},
+			StringFoldComparer(),
+		)
+		assertReiterable(t, "FullOuterJoinWith", result, []string{
+			"Alice@Example.com/true|hi/true",
+			"Alice@Example.com/true|yo/true",
+			"carol@example.com/true|/false",
+			"/false|?/true",
+		})
+	})
+}
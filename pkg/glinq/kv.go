@@ -1,11 +1,19 @@
 package glinq
 
+import "slices"
+
 // KeyValue represents a key-value pair.
 type KeyValue[K comparable, V any] struct {
 	Key   K
 	Value V
 }
 
+// Pair represents two values that belong together, such as adjacent elements.
+type Pair[A, B any] struct {
+	First  A
+	Second B
+}
+
 // FromMap creates a Stream from a map.
 //
 // PERFORMANCE: Only keys are copied (O(n) where n is map size).
@@ -21,27 +29,76 @@ type KeyValue[K comparable, V any] struct {
 //	stream := FromMap(m)
 //	// Efficient - only keys copied, values read on-demand!
 func FromMap[K comparable, V any](m map[K]V) Stream[KeyValue[K, V]] {
-	// Copy only keys to preserve iteration order
+	return fromMapKeys(m, mapKeys(m))
+}
+
+// FromMapSorted creates a Stream from a map with pairs in ascending key order.
+// Unlike FromMap, the order is the same on every run, which keeps reports and tests reproducible.
+//
+// PERFORMANCE: Keys are copied and sorted once (O(n log n)); values are read on-demand as in FromMap.
+//
+// SIZE: Known (number of map entries).
+//
+// Example:
+//
+//	m := map[string]int{"b": 2, "c": 3, "a": 1}
+//	pairs := FromMapSorted(m).ToSlice()
+//	// [{a 1} {b 2} {c 3}]
+func FromMapSorted[K Ordered, V any](m map[K]V) Stream[KeyValue[K, V]] {
+	keys := mapKeys(m)
+	slices.Sort(keys)
+	return fromMapKeys(m, keys)
+}
+
+// FromMapOrderedBy creates a Stream from a map with pairs ordered by the less function on keys.
+// Use it for key types that are not Ordered or when a custom key order is needed.
+// Keys that compare equal under less are emitted in an unspecified order.
+//
+// PERFORMANCE: Keys are copied and sorted once (O(n log n)); values are read on-demand as in FromMap.
+//
+// SIZE: Known (number of map entries).
+//
+// Example:
+//
+//	byDate := FromMapOrderedBy(dailyTotals, func(a, b time.Time) bool { return a.Before(b) })
+func FromMapOrderedBy[K comparable, V any](m map[K]V, less func(a, b K) bool) Stream[KeyValue[K, V]] {
+	keys := mapKeys(m)
+	slices.SortFunc(keys, func(a, b K) int {
+		switch {
+		case less(a, b):
+			return -1
+		case less(b, a):
+			return 1
+		default:
+			return 0
+		}
+	})
+	return fromMapKeys(m, keys)
+}
+
+// mapKeys copies the keys of m in map iteration order.
+func mapKeys[K comparable, V any](m map[K]V) []K {
 	keys := make([]K, 0, len(m))
 	for key := range m {
 		keys = append(keys, key)
 	}
+	return keys
+}
+
+// fromMapKeys creates a Stream of the pairs of m in the order of keys.
+// Values are read from the map on-demand.
+func fromMapKeys[K comparable, V any](m map[K]V, keys []K) Stream[KeyValue[K, V]] {
+	at := func(index int) KeyValue[K, V] {
+		key := keys[index]
+		return KeyValue[K, V]{Key: key, Value: m[key]} // Read value from map on-demand
+	}
 
 	return &stream[KeyValue[K, V]]{
-		sourceFactory: func() func() (KeyValue[K, V], bool) {
-			index := 0 // Fresh index for each iterator
-			return func() (KeyValue[K, V], bool) {
-				if index >= len(keys) {
-					return KeyValue[K, V]{}, false
-				}
-				key := keys[index]
-				// Read value from map on-demand
-				value := m[key]
-				index++
-				return KeyValue[K, V]{Key: key, Value: value}, true
-			}
+		sourceFactory: func(sc *scope) func() (KeyValue[K, V], bool) {
+			return indexedIterator(at, len(keys))
 		},
-		size: len(m),
+		size: len(keys),
+		at:   at,
 	}
 }
 
@@ -66,7 +123,7 @@ func FromMapSafe[K comparable, V any](m map[K]V) Stream[KeyValue[K, V]] {
 	}
 
 	return &stream[KeyValue[K, V]]{
-		sourceFactory: func() func() (KeyValue[K, V], bool) {
+		sourceFactory: func(sc *scope) func() (KeyValue[K, V], bool) {
 			index := 0 // Fresh index for each iterator
 			return func() (KeyValue[K, V], bool) {
 				if index >= len(pairs) {
@@ -97,17 +154,11 @@ func extractFromKeyValue[K comparable, V any, R any](
 	enum Enumerable[KeyValue[K, V]],
 	extractor func(KeyValue[K, V]) R,
 ) Stream[R] {
-	size := -1
-	if sizable, ok := enum.(Sizable[KeyValue[K, V]]); ok {
-		if s, known := sizable.Size(); known {
-			size = s
-		}
-	}
-
 	return &stream[R]{
-		sourceFactory: func() func() (R, bool) {
+		sourceFactory: func(sc *scope) func() (R, bool) {
+			source := iteratorOf(enum, sc) // Get fresh source
 			return func() (R, bool) {
-				kv, ok := enum.Next()
+				kv, ok := source()
 				if !ok {
 					var zero R
 					return zero, false
@@ -115,15 +166,102 @@ func extractFromKeyValue[K comparable, V any, R any](
 				return extractor(kv), true
 			}
 		},
-		size: size,
+		size:    sizeOf(enum), // PRESERVE if possible
+		oneShot: isOneShot(enum),
 	}
 }
 
+// MapValues transforms the value of every pair and keeps its key.
+// This is a function (not a method) because in Go methods cannot have their own type parameters.
+//
+// SIZE: Preserves size if source is Sizable (1-to-1 transformation).
+//
+// Example:
+//
+//	prices := map[string]float64{"apple": 1.5, "pear": 2}
+//	labels := MapValues(FromMapSorted(prices), func(p float64) string { return fmt.Sprintf("$%.2f", p) }).ToSlice()
+//	// [{apple $1.50} {pear $2.00}]
+func MapValues[K comparable, V, R any](enum Enumerable[KeyValue[K, V]], mapper func(V) R) Stream[KeyValue[K, R]] {
+	return Select(enum, func(kv KeyValue[K, V]) KeyValue[K, R] {
+		return KeyValue[K, R]{Key: kv.Key, Value: mapper(kv.Value)}
+	})
+}
+
+// MapKeys transforms the key of every pair and keeps its value.
+// Distinct keys may map to the same new key; all pairs are kept (ToMap keeps the last one).
+// This is a function (not a method) because in Go methods cannot have their own type parameters.
+//
+// SIZE: Preserves size if source is Sizable (1-to-1 transformation).
+//
+// Example:
+//
+//	upper := MapKeys(FromMapSorted(m), strings.ToUpper).ToSlice()
+func MapKeys[K, R comparable, V any](enum Enumerable[KeyValue[K, V]], mapper func(K) R) Stream[KeyValue[R, V]] {
+	return Select(enum, func(kv KeyValue[K, V]) KeyValue[R, V] {
+		return KeyValue[R, V]{Key: mapper(kv.Key), Value: kv.Value}
+	})
+}
+
+// FilterKeys returns the pairs whose key satisfies the predicate.
+// This is a function (not a method) because in Go methods cannot have their own type parameters.
+//
+// SIZE: Loses size (unknown how many keys match).
+//
+// Example:
+//
+//	public := FilterKeys(FromMapSorted(settings), func(k string) bool { return !strings.HasPrefix(k, "_") })
+func FilterKeys[K comparable, V any](enum Enumerable[KeyValue[K, V]], predicate func(K) bool) Stream[KeyValue[K, V]] {
+	return FromEnumerable(enum).Where(func(kv KeyValue[K, V]) bool {
+		return predicate(kv.Key)
+	})
+}
+
+// SortByKey sorts pairs in ascending key order.
+// The sort is stable, and ThenBy can add secondary orderings (for example by value).
+// This is a function (not a method) because in Go methods cannot have their own type parameters.
+//
+// SIZE: Preserves size if source is Sizable.
+//
+// Example:
+//
+//	totals := SortByKey(GroupBy(From(orders), func(o Order) string { return o.Customer })).ToSlice()
+func SortByKey[K Ordered, V any](enum Enumerable[KeyValue[K, V]]) OrderedStream[KeyValue[K, V]] {
+	return OrderByKey(enum, func(kv KeyValue[K, V]) K { return kv.Key })
+}
+
+// SwapKeyValue turns every pair {Key: k, Value: v} into {Key: v, Value: k}.
+// This is a function (not a method) because in Go methods cannot have their own type parameters.
+//
+// SIZE: Preserves size if source is Sizable (1-to-1 transformation).
+//
+// Example:
+//
+//	byCode := ToMap(SwapKeyValue(FromMap(codesByName)))
+func SwapKeyValue[K, V comparable](enum Enumerable[KeyValue[K, V]]) Stream[KeyValue[V, K]] {
+	return Select(enum, func(kv KeyValue[K, V]) KeyValue[V, K] {
+		return KeyValue[V, K]{Key: kv.Value, Value: kv.Key}
+	})
+}
+
+// ToSortedPairs materializes pairs into a slice sorted in ascending key order.
+// The sort is stable, so pairs with equal keys keep their source order.
+//
+// Example:
+//
+//	pairs := ToSortedPairs(FromMap(map[string]int{"b": 2, "a": 1}))
+//	// [{a 1} {b 2}]
+func ToSortedPairs[K Ordered, V any](enum Enumerable[KeyValue[K, V]]) []KeyValue[K, V] {
+	return SortByKey(enum).ToSlice()
+}
+
 // ToMap materializes Enumerable[KeyValue] back into a map.
+// For duplicate keys the last value wins; use ToMapWith or ToMultiMap to handle them.
 func ToMap[K comparable, V any](enum Enumerable[KeyValue[K, V]]) map[K]V {
+	iterator, sc := openEnumerable(enum) // Fresh iterator if possible
+	defer sc.close()
 	result := make(map[K]V)
 	for {
-		kv, ok := enum.Next()
+		kv, ok := iterator()
 		if !ok {
 			break
 		}
@@ -132,10 +270,122 @@ func ToMap[K comparable, V any](enum Enumerable[KeyValue[K, V]]) map[K]V {
 	return result
 }
 
+// DuplicateKeyPolicy decides how ToMapWith resolves a key that appears more than once.
+// It receives the value collected so far and the value of the repeated pair, and returns
+// the value to keep and true, or false to reject the duplicate key.
+// The value type is part of the policy, so a policy for another value type does not compile.
+// Use KeepLast, KeepFirst, ErrorOnDuplicate, MergeValues or a function of your own.
+type DuplicateKeyPolicy[V any] func(existing, incoming V) (V, bool)
+
+// KeepLast is a DuplicateKeyPolicy that keeps the value of the last pair with the key
+// (the behavior of ToMap).
+func KeepLast[V any](_, incoming V) (V, bool) {
+	return incoming, true
+}
+
+// KeepFirst is a DuplicateKeyPolicy that keeps the value of the first pair with the key.
+func KeepFirst[V any](existing, _ V) (V, bool) {
+	return existing, true
+}
+
+// ErrorOnDuplicate is a DuplicateKeyPolicy that rejects every repeated key,
+// so ToMapWith stops at the first one and returns a *DuplicateKeyError.
+func ErrorOnDuplicate[V any](_, _ V) (V, bool) {
+	var zero V
+	return zero, false
+}
+
+// MergeValues returns a DuplicateKeyPolicy that combines the values of a repeated key with merge.
+// merge receives the value collected so far and the value of the repeated pair.
+//
+// Example:
+//
+//	totals, _ := ToMapWith(pairs, MergeValues(func(sum, total float64) float64 { return sum + total }))
+func MergeValues[V any](merge func(existing, incoming V) V) DuplicateKeyPolicy[V] {
+	return func(existing, incoming V) (V, bool) {
+		return merge(existing, incoming), true
+	}
+}
+
+// ToMapWith materializes Enumerable[KeyValue] into a map, resolving duplicate keys by policy.
+// When the policy rejects a key (ErrorOnDuplicate) it returns nil and a *DuplicateKeyError
+// holding the offending key (errors.Is(err, ErrDuplicateKey) reports true);
+// KeepLast, KeepFirst and MergeValues never return an error.
+//
+// Example:
+//
+//	byEmail, err := ToMapWith(Select(From(users), func(u User) KeyValue[string, User] {
+//	    return KeyValue[string, User]{Key: u.Email, Value: u}
+//	}), ErrorOnDuplicate)
+//	var dup *DuplicateKeyError[string]
+//	if errors.As(err, &dup) {
+//	    log.Printf("email %s is used twice", dup.Key)
+//	}
+func ToMapWith[K comparable, V any](enum Enumerable[KeyValue[K, V]], policy DuplicateKeyPolicy[V]) (map[K]V, error) {
+	iterator, sc := openEnumerable(enum) // Fresh iterator if possible
+	defer sc.close()
+	result := make(map[K]V)
+	for {
+		kv, ok := iterator()
+		if !ok {
+			return result, nil
+		}
+		if existing, exists := result[kv.Key]; exists {
+			value, accepted := policy(existing, kv.Value)
+			if !accepted {
+				return nil, &DuplicateKeyError[K]{Key: kv.Key}
+			}
+			result[kv.Key] = value
+			continue
+		}
+		result[kv.Key] = kv.Value
+	}
+}
+
+// ToMapMerge materializes Enumerable[KeyValue] into a map, combining the values of duplicate keys
+// with merge. It is shorthand for ToMapWith with MergeValues(merge), which never returns an error.
+//
+// Example:
+//
+//	totals := ToMapMerge(Select(From(orders), func(o Order) KeyValue[string, float64] {
+//	    return KeyValue[string, float64]{Key: o.Customer, Value: o.Total}
+//	}), func(sum, total float64) float64 { return sum + total })
+func ToMapMerge[K comparable, V any](enum Enumerable[KeyValue[K, V]], merge func(existing, incoming V) V) map[K]V {
+	result, _ := ToMapWith(enum, MergeValues(merge))
+	return result
+}
+
+// ToMultiMap materializes Enumerable[KeyValue] into a map from every key to all of its values,
+// in source order. No value is lost on duplicate keys.
+//
+// Example:
+//
+//	tags := ToMultiMap(From([]KeyValue[string, string]{{"post1", "go"}, {"post2", "db"}, {"post1", "linq"}}))
+//	// map[string][]string{"post1": {"go", "linq"}, "post2": {"db"}}
+func ToMultiMap[K comparable, V any](enum Enumerable[KeyValue[K, V]]) map[K][]V {
+	iterator, sc := openEnumerable(enum) // Fresh iterator if possible
+	defer sc.close()
+	result := make(map[K][]V)
+	for {
+		kv, ok := iterator()
+		if !ok {
+			return result
+		}
+		result[kv.Key] = append(result[kv.Key], kv.Value)
+	}
+}
+
 // GroupBy groups elements by a key selector and returns a Stream of KeyValue pairs.
 // Each KeyValue contains a key and a slice of elements that have that key.
+// Groups are emitted in the order their keys are first seen, and elements within a group
+// keep their source order.
 // This is a function (not a method) because in Go methods cannot have their own type parameters.
 //
+// LAZY: The source is not read until the first element of the result is requested.
+// Each iteration regroups a fresh pass over the source.
+//
+// SIZE: Unknown (number of groups is known only after grouping).
+//
 // Example:
 //
 //	type Person struct { Age int; Name string }
@@ -149,36 +399,85 @@ func ToMap[K comparable, V any](enum Enumerable[KeyValue[K, V]]) map[K]V {
 //	//   {Key: 30, Value: []Person{{30, "Bob"}}},
 //	// }
 func GroupBy[T any, K comparable](enum Enumerable[T], keySelector func(T) K) Stream[KeyValue[K, []T]] {
-	// Materialize groups into a map
+	return &stream[KeyValue[K, []T]]{
+		sourceFactory: func(sc *scope) func() (KeyValue[K, []T], bool) {
+			return deferredIterator(func() []KeyValue[K, []T] {
+				keys, groups := groupInOrder(iteratorOf(enum, sc), keySelector) // Fresh pass over source
+				result := make([]KeyValue[K, []T], len(keys))
+				for i, key := range keys {
+					result[i] = KeyValue[K, []T]{Key: key, Value: groups[key]}
+				}
+				return result
+			})
+		},
+		size:    -1, // UNKNOWN: groups are counted only during iteration
+		oneShot: isOneShot(enum),
+	}
+}
+
+// GroupByWith groups elements by a key selector, using comparer to decide key equality.
+// Each group is keyed by the first key seen for it. Groups are emitted in first-seen key order
+// and elements within a group keep their source order.
+// This is a function (not a method) because in Go methods cannot have their own type parameters.
+//
+// LAZY: The source is not read until the first element of the result is requested.
+//
+// SIZE: Unknown (number of groups is known only after grouping).
+//
+// Example:
+//
+//	byTag := GroupByWith(
+//	    From(posts),
+//	    func(p Post) string { return p.Tag },
+//	    StringFoldComparer(),
+//	).ToSlice()
+//	// "Go", "go" and "GO" posts end up in one group keyed by the first spelling seen
+func GroupByWith[T any, K comparable](
+	enum Enumerable[T],
+	keySelector func(T) K,
+	comparer EqualityComparer[K],
+) Stream[KeyValue[K, []T]] {
+	return &stream[KeyValue[K, []T]]{
+		sourceFactory: func(sc *scope) func() (KeyValue[K, []T], bool) {
+			return deferredIterator(func() []KeyValue[K, []T] {
+				index := newHashIndex(comparer)
+				var groups []KeyValue[K, []T]
+				next := iteratorOf(enum, sc) // Fresh pass over source
+				for {
+					elem, ok := next()
+					if !ok {
+						return groups
+					}
+					key := keySelector(elem)
+					i, added := index.add(key)
+					if added {
+						groups = append(groups, KeyValue[K, []T]{Key: key})
+					}
+					groups[i].Value = append(groups[i].Value, elem)
+				}
+			})
+		},
+		size:    -1, // UNKNOWN: groups are counted only during iteration
+		oneShot: isOneShot(enum),
+	}
+}
+
+// groupInOrder drains next and groups its elements by key.
+// keys lists every distinct key in first-seen order.
+func groupInOrder[T any, K comparable](next func() (T, bool), keySelector func(T) K) ([]K, map[K][]T) {
+	var keys []K
 	groups := make(map[K][]T)
 	for {
-		elem, ok := enum.Next()
+		elem, ok := next()
 		if !ok {
 			break
 		}
 		key := keySelector(elem)
-		groups[key] = append(groups[key], elem)
-	}
-
-	// Convert map to slice of KeyValue pairs
-	pairs := make([]KeyValue[K, []T], 0, len(groups))
-	for key, values := range groups {
-		pairs = append(pairs, KeyValue[K, []T]{Key: key, Value: values})
-	}
-
-	// SIZE: Known after materialization
-	return &stream[KeyValue[K, []T]]{
-		sourceFactory: func() func() (KeyValue[K, []T], bool) {
-			index := 0 // Fresh index for each iterator
-			return func() (KeyValue[K, []T], bool) {
-				if index >= len(pairs) {
-					return KeyValue[K, []T]{}, false
-				}
-				result := pairs[index]
-				index++
-				return result, true
-			}
-		},
-		size: len(pairs), // Actually we know it after materialization
+		group, exists := groups[key]
+		if !exists {
+			keys = append(keys, key)
+		}
+		groups[key] = append(group, elem)
 	}
+	return keys, groups
 }
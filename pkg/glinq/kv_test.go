@@ -1,7 +1,10 @@
 package glinq
 
 import (
+	"errors"
 	"fmt"
+	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -103,6 +106,124 @@ func TestToMap(t *testing.T) {
 	}
 }
 
+func TestToMapWith(t *testing.T) {
+	pairs := []KeyValue[string, int]{{"a", 1}, {"b", 2}, {"a", 3}, {"b", 4}, {"a", 5}}
+
+	t.Run("KeepLast", func(t *testing.T) {
+		result, err := ToMapWith(From(pairs), KeepLast)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if expected := (map[string]int{"a": 5, "b": 4}); !reflect.DeepEqual(result, expected) {
+			t.Errorf("Expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("KeepFirst", func(t *testing.T) {
+		result, err := ToMapWith(From(pairs), KeepFirst)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if expected := (map[string]int{"a": 1, "b": 2}); !reflect.DeepEqual(result, expected) {
+			t.Errorf("Expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("ErrorOnDuplicate", func(t *testing.T) {
+		result, err := ToMapWith(From(pairs), ErrorOnDuplicate)
+		if result != nil {
+			t.Errorf("Expected nil map, got %v", result)
+		}
+		if !errors.Is(err, ErrDuplicateKey) {
+			t.Fatalf("Expected ErrDuplicateKey, got %v", err)
+		}
+		var dup *DuplicateKeyError[string]
+		if !errors.As(err, &dup) || dup.Key != "a" {
+			t.Errorf("Expected duplicate key a, got %v", err)
+		}
+		if err.Error() != "glinq: duplicate key: a" {
+			t.Errorf("Unexpected message %q", err.Error())
+		}
+	})
+
+	t.Run("ErrorOnDuplicate unique keys", func(t *testing.T) {
+		result, err := ToMapWith(FromMap(map[int]string{1: "x", 2: "y"}), ErrorOnDuplicate)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(result) != 2 {
+			t.Errorf("Expected 2 entries, got %v", result)
+		}
+	})
+
+	t.Run("ErrorOnDuplicate stops early", func(t *testing.T) {
+		pulled := 0
+		s := Select(From(pairs), func(kv KeyValue[string, int]) KeyValue[string, int] {
+			pulled++
+			return kv
+		})
+		if _, err := ToMapWith(s, ErrorOnDuplicate); err == nil {
+			t.Fatal("Expected error")
+		}
+		if pulled != 3 {
+			t.Errorf("Expected to stop at the third pair, pulled %d", pulled)
+		}
+	})
+
+	t.Run("MergeValues", func(t *testing.T) {
+		result, err := ToMapWith(From(pairs), MergeValues(func(existing, incoming int) int { return existing + incoming }))
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if expected := (map[string]int{"a": 9, "b": 6}); !reflect.DeepEqual(result, expected) {
+			t.Errorf("Expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("Custom policy", func(t *testing.T) {
+		var longest DuplicateKeyPolicy[string] = func(existing, incoming string) (string, bool) {
+			if len(incoming) > len(existing) {
+				return incoming, true
+			}
+			return existing, true
+		}
+		words := []KeyValue[byte, string]{{'g', "go"}, {'g', "gopher"}, {'g', "gc"}}
+		result, err := ToMapWith(From(words), longest)
+		if err != nil || result['g'] != "gopher" {
+			t.Errorf("Expected gopher, nil, got %v, %v", result, err)
+		}
+	})
+}
+
+func TestToMapMerge(t *testing.T) {
+	pairs := []KeyValue[string, int]{{"a", 1}, {"b", 2}, {"a", 3}, {"a", 5}}
+	result := ToMapMerge(From(pairs), func(existing, incoming int) int { return existing + incoming })
+	if expected := (map[string]int{"a": 9, "b": 2}); !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+
+	var order []string
+	ToMapMerge(From([]KeyValue[int, string]{{1, "x"}, {1, "y"}}), func(existing, incoming string) string {
+		order = append(order, existing, incoming)
+		return existing + incoming
+	})
+	if !reflect.DeepEqual(order, []string{"x", "y"}) {
+		t.Errorf("Expected merge(existing, incoming), got %v", order)
+	}
+}
+
+func TestToMultiMap(t *testing.T) {
+	pairs := []KeyValue[string, int]{{"a", 1}, {"b", 2}, {"a", 3}}
+	result := ToMultiMap(From(pairs))
+	if expected := (map[string][]int{"a": {1, 3}, "b": {2}}); !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+
+	if result := ToMultiMap(Empty[KeyValue[string, int]]()); result == nil || len(result) != 0 {
+		t.Errorf("Expected empty non-nil map, got %v", result)
+	}
+}
+
 func TestMapFilter(t *testing.T) {
 	m := map[string]int{
 		"apple":  5,
@@ -266,6 +387,115 @@ func BenchmarkFromSafeON(b *testing.B) {
 	}
 }
 
+func TestFromMapSorted(t *testing.T) {
+	m := map[string]int{"c": 3, "a": 1, "d": 4, "b": 2}
+	expected := []KeyValue[string, int]{{"a", 1}, {"b", 2}, {"c", 3}, {"d", 4}}
+
+	for i := 0; i < 5; i++ {
+		if result := FromMapSorted(m).ToSlice(); !reflect.DeepEqual(result, expected) {
+			t.Fatalf("Expected %v, got %v", expected, result)
+		}
+	}
+
+	s := FromMapSorted(m)
+	if size, ok := s.Size(); !ok || size != 4 {
+		t.Errorf("Expected known size 4, got %d (%v)", size, ok)
+	}
+	if kv, ok := s.ElementAt(2); !ok || kv.Key != "c" {
+		t.Errorf("Expected c at index 2, got %v (%v)", kv, ok)
+	}
+	if result := FromMapSorted(map[string]int{}).ToSlice(); len(result) != 0 {
+		t.Errorf("Expected empty result, got %v", result)
+	}
+}
+
+func TestFromMapOrderedBy(t *testing.T) {
+	type point struct{ X, Y int }
+	m := map[point]string{{2, 1}: "c", {1, 5}: "b", {1, 2}: "a"}
+	less := func(a, b point) bool {
+		if a.X != b.X {
+			return a.X < b.X
+		}
+		return a.Y < b.Y
+	}
+
+	result := Values(FromMapOrderedBy(m, less)).ToSlice()
+	expected := []string{"a", "b", "c"}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+
+	descending := Keys(FromMapOrderedBy(map[int]bool{1: true, 3: true, 2: true}, func(a, b int) bool { return a > b }))
+	if result := descending.ToSlice(); !reflect.DeepEqual(result, []int{3, 2, 1}) {
+		t.Errorf("Expected [3 2 1], got %v", result)
+	}
+}
+
+func TestKeyValueOperators(t *testing.T) {
+	m := map[string]int{"b": 2, "a": 1, "c": 3}
+
+	t.Run("MapValues", func(t *testing.T) {
+		result := MapValues(FromMapSorted(m), func(v int) string { return strings.Repeat("*", v) }).ToSlice()
+		expected := []KeyValue[string, string]{{"a", "*"}, {"b", "**"}, {"c", "***"}}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("Expected %v, got %v", expected, result)
+		}
+		if size, ok := MapValues(FromMap(m), func(v int) int { return v }).Size(); !ok || size != 3 {
+			t.Errorf("Expected known size 3, got %d (%v)", size, ok)
+		}
+	})
+
+	t.Run("MapKeys", func(t *testing.T) {
+		result := MapKeys(FromMapSorted(m), strings.ToUpper).ToSlice()
+		expected := []KeyValue[string, int]{{"A", 1}, {"B", 2}, {"C", 3}}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("Expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("FilterKeys", func(t *testing.T) {
+		result := FilterKeys(FromMapSorted(m), func(k string) bool { return k != "b" }).ToSlice()
+		expected := []KeyValue[string, int]{{"a", 1}, {"c", 3}}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("Expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("SortByKey", func(t *testing.T) {
+		pairs := []KeyValue[string, int]{{"b", 2}, {"a", 9}, {"b", 1}, {"a", 3}}
+		result := SortByKey(From(pairs)).ToSlice()
+		expected := []KeyValue[string, int]{{"a", 9}, {"a", 3}, {"b", 2}, {"b", 1}}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("Expected stable order %v, got %v", expected, result)
+		}
+
+		byValue := ThenByKey(SortByKey(From(pairs)), func(kv KeyValue[string, int]) int { return kv.Value }).ToSlice()
+		expected = []KeyValue[string, int]{{"a", 3}, {"a", 9}, {"b", 1}, {"b", 2}}
+		if !reflect.DeepEqual(byValue, expected) {
+			t.Errorf("Expected %v, got %v", expected, byValue)
+		}
+	})
+
+	t.Run("SwapKeyValue", func(t *testing.T) {
+		result := ToMap(SwapKeyValue(FromMap(m)))
+		expected := map[int]string{1: "a", 2: "b", 3: "c"}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("Expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("ToSortedPairs", func(t *testing.T) {
+		result := ToSortedPairs(FromMap(m))
+		expected := []KeyValue[string, int]{{"a", 1}, {"b", 2}, {"c", 3}}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("Expected %v, got %v", expected, result)
+		}
+		if result := ToSortedPairs(Empty[KeyValue[string, int]]()); len(result) != 0 {
+			t.Errorf("Expected empty result, got %v", result)
+		}
+	})
+}
+
 func TestGroupBy(t *testing.T) {
 	t.Run("Group by age", func(t *testing.T) {
 		type Person struct {
@@ -401,3 +631,20 @@ func TestGroupBy(t *testing.T) {
 		}
 	})
 }
+
+func TestGroupByWith(t *testing.T) {
+	words := From([]string{"Apple", "avocado", "banana", "apricot", "Blueberry"})
+	initial := func(s string) string { return s[:1] }
+
+	groups := GroupByWith(words, initial, StringFoldComparer()).ToSlice()
+
+	if len(groups) != 2 {
+		t.Fatalf("Expected 2 groups, got %d: %v", len(groups), groups)
+	}
+	if groups[0].Key != "A" || fmt.Sprint(groups[0].Value) != "[Apple avocado apricot]" {
+		t.Errorf("Unexpected first group %v", groups[0])
+	}
+	if groups[1].Key != "b" || fmt.Sprint(groups[1].Value) != "[banana Blueberry]" {
+		t.Errorf("Unexpected second group %v", groups[1])
+	}
+}
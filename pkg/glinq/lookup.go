@@ -0,0 +1,75 @@
+package glinq
+
+import "slices"
+
+// Lookup is an immutable, materialized grouping of elements by key.
+// Keys keep the order in which they were first seen in the source.
+// Unlike GroupBy, a Lookup is built eagerly and supports lookups by key.
+type Lookup[K comparable, T any] struct {
+	keys   []K
+	groups map[K][]T
+}
+
+// ToLookup materializes Enumerable[T] into a Lookup grouped by keySelector.
+// This is a function (not a method) because in Go methods cannot have their own type parameters.
+//
+// Example:
+//
+//	type Person struct { Age int; Name string }
+//	people := []Person{{25, "Alice"}, {30, "Bob"}, {25, "Charlie"}}
+//	byAge := ToLookup(From(people), func(p Person) int { return p.Age })
+//	names := Select(byAge.Get(25), func(p Person) string { return p.Name }).ToSlice()
+//	// ["Alice", "Charlie"]
+func ToLookup[T any, K comparable](enum Enumerable[T], keySelector func(T) K) *Lookup[K, T] {
+	next, sc := openEnumerable(enum)
+	defer sc.close()
+	keys, groups := groupInOrder(next, keySelector)
+	return &Lookup[K, T]{keys: keys, groups: groups}
+}
+
+// Get returns the elements with the specified key.
+// Returns an empty Stream if the key is not present.
+//
+// SIZE: Known (size of the group).
+func (l *Lookup[K, T]) Get(key K) Stream[T] {
+	return From(l.groups[key])
+}
+
+// Contains checks if the Lookup has a group for the specified key.
+func (l *Lookup[K, T]) Contains(key K) bool {
+	_, ok := l.groups[key]
+	return ok
+}
+
+// Count returns the number of groups (distinct keys) in the Lookup.
+func (l *Lookup[K, T]) Count() int {
+	return len(l.keys)
+}
+
+// Keys returns the distinct keys in first-seen order.
+//
+// SIZE: Known (number of groups).
+func (l *Lookup[K, T]) Keys() Stream[K] {
+	return From(l.keys)
+}
+
+// Groups returns the groups as KeyValue pairs in first-seen key order.
+// Each group is a copy, so modifying it does not change the Lookup.
+//
+// SIZE: Known (number of groups).
+func (l *Lookup[K, T]) Groups() Stream[KeyValue[K, []T]] {
+	return &stream[KeyValue[K, []T]]{
+		sourceFactory: func(sc *scope) func() (KeyValue[K, []T], bool) {
+			index := 0 // Fresh index for each iterator
+			return func() (KeyValue[K, []T], bool) {
+				if index >= len(l.keys) {
+					return KeyValue[K, []T]{}, false
+				}
+				key := l.keys[index]
+				index++
+				return KeyValue[K, []T]{Key: key, Value: slices.Clone(l.groups[key])}, true
+			}
+		},
+		size: len(l.keys),
+	}
+}
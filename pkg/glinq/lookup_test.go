@@ -0,0 +1,118 @@
+package glinq
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestToLookup(t *testing.T) {
+	type Person struct {
+		Age  int
+		Name string
+	}
+	people := []Person{{30, "Bob"}, {25, "Alice"}, {30, "David"}, {25, "Charlie"}}
+	lookup := ToLookup(From(people), func(p Person) int { return p.Age })
+
+	t.Run("Get", func(t *testing.T) {
+		result := lookup.Get(30).ToSlice()
+		expected := []Person{{30, "Bob"}, {30, "David"}}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("Expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("Get missing key", func(t *testing.T) {
+		if lookup.Get(99).Any() {
+			t.Error("Expected empty stream for missing key")
+		}
+	})
+
+	t.Run("Contains", func(t *testing.T) {
+		if !lookup.Contains(25) || lookup.Contains(40) {
+			t.Error("Contains returned unexpected result")
+		}
+	})
+
+	t.Run("Count", func(t *testing.T) {
+		if lookup.Count() != 2 {
+			t.Errorf("Expected 2 groups, got %d", lookup.Count())
+		}
+	})
+
+	t.Run("Keys in first-seen order", func(t *testing.T) {
+		result := lookup.Keys().ToSlice()
+		expected := []int{30, 25}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("Expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("Groups", func(t *testing.T) {
+		groups := lookup.Groups()
+		if size, ok := groups.Size(); !ok || size != 2 {
+			t.Errorf("Expected known size 2, got %d (known=%v)", size, ok)
+		}
+		result := groups.ToSlice()
+		if result[0].Key != 30 || len(result[0].Value) != 2 || result[1].Key != 25 {
+			t.Errorf("Unexpected groups: %v", result)
+		}
+		if groups.Count() != 2 {
+			t.Error("Expected Groups to be re-iterable")
+		}
+	})
+}
+
+func TestGroupByLazyOrdered(t *testing.T) {
+	t.Run("Preserves first-seen key order", func(t *testing.T) {
+		words := []string{"cherry", "apple", "banana", "avocado", "blueberry", "cranberry"}
+		result := GroupBy(From(words), func(s string) byte { return s[0] }).ToSlice()
+
+		expected := []KeyValue[byte, []string]{
+			{Key: 'c', Value: []string{"cherry", "cranberry"}},
+			{Key: 'a', Value: []string{"apple", "avocado"}},
+			{Key: 'b', Value: []string{"banana", "blueberry"}},
+		}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("Expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("Defers work until iteration", func(t *testing.T) {
+		calls := 0
+		grouped := GroupBy(From([]int{1, 2, 3}), func(x int) int {
+			calls++
+			return x % 2
+		})
+		if calls != 0 {
+			t.Errorf("Expected no key selector calls before iteration, got %d", calls)
+		}
+		grouped.ToSlice()
+		if calls != 3 {
+			t.Errorf("Expected 3 key selector calls, got %d", calls)
+		}
+	})
+
+	t.Run("Sees upstream changes", func(t *testing.T) {
+		data := []int{1, 2, 3}
+		grouped := GroupBy(From(data), func(x int) int { return x })
+		data[0] = 2
+		if count := grouped.Count(); count != 2 {
+			t.Errorf("Expected 2 groups after modification, got %d", count)
+		}
+	})
+}
+
+func TestLookupGroupsAreCopies(t *testing.T) {
+	lookup := ToLookup(From([]int{1, 2, 3, 4}), func(x int) bool { return x%2 == 0 })
+
+	for _, group := range lookup.Groups().ToSlice() {
+		group.Value[0] = 100
+	}
+
+	if evens := lookup.Get(true).ToSlice(); evens[0] != 2 {
+		t.Errorf("Expected Lookup to be unchanged, got %v", evens)
+	}
+	if odds := lookup.Get(false).ToSlice(); odds[0] != 1 {
+		t.Errorf("Expected Lookup to be unchanged, got %v", odds)
+	}
+}
@@ -0,0 +1,208 @@
+package glinq
+
+import "slices"
+
+// MergeSorted merges Enumerables that are each sorted by less into one sorted Stream (k-way merge).
+// Elements that compare equal keep the order of their sources: earlier arguments come first.
+// This is a function (not a method) because in Go methods cannot have their own type parameters.
+//
+// LAZY: Only the current head of every source is held in a heap; each Next() pulls one element.
+// Inputs that are not sorted by less produce an unspecified order.
+//
+// SIZE: Calculated as the sum of the source sizes if all are known, else unknown.
+//
+// Example:
+//
+//	merged := MergeSorted(
+//	    func(a, b LogEntry) bool { return a.Time.Before(b.Time) },
+//	    FromSeq(segment1), FromSeq(segment2), FromSeq(segment3),
+//	).ToSlice()
+//
+//nolint:gocognit
+func MergeSorted[T any](less func(a, b T) bool, enums ...Enumerable[T]) Stream[T] {
+	size := 0
+	for _, enum := range enums {
+		s := sizeOf(enum)
+		if s == -1 {
+			size = -1
+			break
+		}
+		size += s
+	}
+
+	type head struct {
+		value  T
+		source int
+	}
+	// heapifyDown keeps the "largest" element at the root; invert the order to get a min-heap
+	after := func(a, b head) bool {
+		if less(b.value, a.value) {
+			return true
+		}
+		if less(a.value, b.value) {
+			return false
+		}
+		return a.source > b.source // Ties: earlier source first
+	}
+
+	return &stream[T]{
+		sourceFactory: func(sc *scope) func() (T, bool) {
+			var sources []func() (T, bool)
+			var heap []head
+			started := false
+
+			return func() (T, bool) {
+				if !started {
+					// Pull the first element of every source on first Next()
+					started = true
+					sources = make([]func() (T, bool), len(enums))
+					for i, enum := range enums {
+						sources[i] = iteratorOf(enum, sc) // Get fresh sources
+						if value, ok := sources[i](); ok {
+							heap = append(heap, head{value: value, source: i})
+						}
+					}
+					buildHeap(heap, after)
+				}
+
+				if len(heap) == 0 {
+					var zero T
+					return zero, false
+				}
+
+				top := heap[0]
+				if value, ok := sources[top.source](); ok {
+					heap[0] = head{value: value, source: top.source}
+				} else {
+					last := len(heap) - 1
+					heap[0] = heap[last]
+					heap = heap[:last]
+				}
+				heapifyDown(heap, 0, len(heap), after)
+				return top.value, true
+			}
+		},
+		size:    size,
+		oneShot: slices.ContainsFunc(enums, isOneShot[T]),
+	}
+}
+
+// SortedUnion returns the distinct elements of two Enumerables sorted by less, in sorted order.
+// Elements are equal when neither is less than the other; the first of equal elements is kept
+// (elements of e1 win over equal elements of e2).
+// This is a function (not a method) because in Go methods cannot have their own type parameters.
+//
+// LAZY: A single linear pass over both inputs with no hash maps. Inputs must be sorted by less.
+//
+// SIZE: Loses size (unknown how many duplicates exist).
+//
+// Example:
+//
+//	less := func(a, b int) bool { return a < b }
+//	union := SortedUnion(From([]int{1, 3, 5}), From([]int{1, 2, 3}), less).ToSlice()
+//	// [1, 2, 3, 5]
+func SortedUnion[T any](e1, e2 Enumerable[T], less func(a, b T) bool) Stream[T] {
+	return sortedSetOperation(e1, e2, less, false, false, func(inFirst, inSecond bool) bool {
+		return inFirst || inSecond
+	})
+}
+
+// SortedIntersect returns the distinct elements present in both Enumerables sorted by less, in sorted order.
+// This is a function (not a method) because in Go methods cannot have their own type parameters.
+//
+// LAZY: A single linear pass over both inputs with no hash maps. Inputs must be sorted by less.
+// Stops as soon as either input is exhausted.
+//
+// SIZE: Loses size (unknown result count).
+//
+// Example:
+//
+//	less := func(a, b int) bool { return a < b }
+//	common := SortedIntersect(From([]int{1, 2, 2, 4}), From([]int{2, 3, 4}), less).ToSlice()
+//	// [2, 4]
+func SortedIntersect[T any](e1, e2 Enumerable[T], less func(a, b T) bool) Stream[T] {
+	return sortedSetOperation(e1, e2, less, true, true, func(inFirst, inSecond bool) bool {
+		return inFirst && inSecond
+	})
+}
+
+// SortedExcept returns the distinct elements of e1 that are not present in e2, both sorted by less,
+// in sorted order.
+// This is a function (not a method) because in Go methods cannot have their own type parameters.
+//
+// LAZY: A single linear pass over both inputs with no hash maps. Inputs must be sorted by less.
+//
+// SIZE: Loses size (unknown result count).
+//
+// Example:
+//
+//	less := func(a, b int) bool { return a < b }
+//	rest := SortedExcept(From([]int{1, 2, 3, 4}), From([]int{2, 4}), less).ToSlice()
+//	// [1, 3]
+func SortedExcept[T any](e1, e2 Enumerable[T], less func(a, b T) bool) Stream[T] {
+	return sortedSetOperation(e1, e2, less, true, false, func(inFirst, inSecond bool) bool {
+		return inFirst && !inSecond
+	})
+}
+
+// sortedSetOperation walks two sorted inputs in lockstep. For every distinct value it consumes
+// all equal elements from both sides and emits the value if keep reports true for where it was found.
+// If firstOnly is set, only values of e1 can be kept, so e2 is not read past the end of e1.
+// If bothRequired is set, only values of both inputs can be kept, so e1 is not read past the end of e2.
+//
+//nolint:gocognit
+func sortedSetOperation[T any](
+	e1, e2 Enumerable[T],
+	less func(a, b T) bool,
+	firstOnly, bothRequired bool,
+	keep func(inFirst, inSecond bool) bool,
+) Stream[T] {
+	return &stream[T]{
+		sourceFactory: func(sc *scope) func() (T, bool) {
+			var source1, source2 func() (T, bool)
+			var head1, head2 T
+			var ok1, ok2 bool
+			started := false
+
+			return func() (T, bool) {
+				if !started {
+					// Pull the first element of both sources on first Next()
+					started = true
+					source1, source2 = iteratorOf(e1, sc), iteratorOf(e2, sc) // Get fresh sources
+					head1, ok1 = source1()
+					head2, ok2 = source2()
+				}
+
+				for (ok1 || (ok2 && !firstOnly)) && (ok2 || !bothRequired) {
+					// The smallest head is the next distinct value
+					var value T
+					switch {
+					case !ok2 || (ok1 && !less(head2, head1)):
+						value = head1
+					default:
+						value = head2
+					}
+
+					inFirst, inSecond := false, false
+					for ok1 && !less(value, head1) {
+						inFirst = true
+						head1, ok1 = source1()
+					}
+					for ok2 && !less(value, head2) {
+						inSecond = true
+						head2, ok2 = source2()
+					}
+
+					if keep(inFirst, inSecond) {
+						return value, true
+					}
+				}
+
+				var zero T
+				return zero, false
+			}
+		},
+		size:    -1, // LOSE: unknown result count
+		oneShot: isOneShot(e1) || isOneShot(e2),
+	}
+}
@@ -0,0 +1,122 @@
+package glinq
+
+import (
+	"reflect"
+	"testing"
+)
+
+func intLess(a, b int) bool { return a < b }
+
+func TestMergeSorted(t *testing.T) {
+	t.Run("K-way merge", func(t *testing.T) {
+		result := MergeSorted(intLess,
+			From([]int{1, 4, 7}),
+			From([]int{2, 5, 8, 9}),
+			Empty[int](),
+			From([]int{0, 3, 6}),
+		)
+		assertReiterable(t, "MergeSorted", result, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9})
+		assertSize(t, result, 10, "MergeSorted")
+	})
+
+	t.Run("Ties keep source order", func(t *testing.T) {
+		type entry struct {
+			key    int
+			source string
+		}
+		byKey := func(a, b entry) bool { return a.key < b.key }
+		result := MergeSorted(byKey,
+			From([]entry{{1, "a"}, {2, "a"}}),
+			From([]entry{{1, "b"}, {2, "b"}}),
+			From([]entry{{1, "c"}}),
+		).ToSlice()
+
+		got := ""
+		for _, e := range result {
+			got += e.source
+		}
+		if got != "abcab" {
+			t.Errorf("Expected sources in order abcab, got %s", got)
+		}
+	})
+
+	t.Run("Lazy", func(t *testing.T) {
+		pulled := 0
+		counting := Range(0, 1000).Select(func(x int) int {
+			pulled++
+			return x
+		})
+		first := MergeSorted(intLess, counting, Range(0, 1000)).Take(3).ToSlice()
+		if !reflect.DeepEqual(first, []int{0, 0, 1}) {
+			t.Errorf("Expected [0 0 1], got %v", first)
+		}
+		if pulled > 3 {
+			t.Errorf("Expected at most 3 pulls from the first source, got %d", pulled)
+		}
+	})
+
+	t.Run("No sources", func(t *testing.T) {
+		if n := MergeSorted(intLess).Count(); n != 0 {
+			t.Errorf("Expected empty result, got %d", n)
+		}
+	})
+}
+
+func TestSortedSetOperations(t *testing.T) {
+	a := From([]int{1, 1, 2, 4, 6, 6, 9})
+	b := From([]int{0, 2, 2, 3, 6, 10})
+
+	assertReiterable(t, "SortedUnion", SortedUnion(a, b, intLess), []int{0, 1, 2, 3, 4, 6, 9, 10})
+	assertReiterable(t, "SortedIntersect", SortedIntersect(a, b, intLess), []int{2, 6})
+	assertReiterable(t, "SortedExcept", SortedExcept(a, b, intLess), []int{1, 4, 9})
+	assertReiterable(t, "SortedExcept reversed", SortedExcept(b, a, intLess), []int{0, 3, 10})
+
+	t.Run("Empty inputs", func(t *testing.T) {
+		assertReiterable(t, "SortedUnion", SortedUnion(Empty[int](), b, intLess), []int{0, 2, 3, 6, 10})
+		assertReiterable(t, "SortedIntersect", SortedIntersect(a, Empty[int](), intLess), nil)
+		assertReiterable(t, "SortedExcept", SortedExcept(a, Empty[int](), intLess), []int{1, 2, 4, 6, 9})
+	})
+
+	t.Run("Stops at the end of e1", func(t *testing.T) {
+		inc := func(x int) int { return x + 1 }
+		assertReiterable(t, "SortedExcept", SortedExcept(From([]int{1, 2}), Iterate(5, inc), intLess), []int{1, 2})
+		assertReiterable(t, "SortedIntersect", SortedIntersect(From([]int{5, 7}), Iterate(5, inc), intLess), []int{5, 7})
+
+		pulled := 0
+		counted := Select(From([]int{1, 2, 3, 4, 5, 6}), func(x int) int {
+			pulled++
+			return x
+		})
+		SortedExcept(From([]int{2}), counted, intLess).ToSlice()
+		if pulled != 3 {
+			t.Errorf("Expected e2 to be read up to the first element past e1 (3 pulls), got %d", pulled)
+		}
+	})
+
+	t.Run("SortedIntersect stops at the end of e2", func(t *testing.T) {
+		inc := func(x int) int { return x + 1 }
+		assertReiterable(t, "SortedIntersect", SortedIntersect(Iterate(0, inc), From([]int{1, 2}), intLess), []int{1, 2})
+
+		pulled := 0
+		counted := Select(Range(0, 100000), func(x int) int {
+			pulled++
+			return x
+		})
+		SortedIntersect(counted, From([]int{1, 2}), intLess).ToSlice()
+		if pulled != 4 {
+			t.Errorf("Expected e1 to be read up to the first element past e2 (4 pulls), got %d", pulled)
+		}
+	})
+
+	t.Run("First of equal elements wins", func(t *testing.T) {
+		type row struct{ id, version int }
+		byID := func(x, y row) bool { return x.id < y.id }
+		result := SortedUnion(From([]row{{1, 1}, {3, 1}}), From([]row{{1, 2}, {2, 2}}), byID).ToSlice()
+		expected := []row{{1, 1}, {2, 2}, {3, 1}}
+		for i := range expected {
+			if i >= len(result) || result[i] != expected[i] {
+				t.Fatalf("Expected %v, got %v", expected, result)
+			}
+		}
+	})
+}
@@ -24,9 +24,11 @@ type Ordered interface {
 //	sum := Sum(From(numbers))
 //	// 15
 func Sum[T Numeric](enum Enumerable[T]) T {
+	iterator, sc := openEnumerable(enum) // Fresh iterator if possible
+	defer sc.close()
 	var sum T
 	for {
-		value, ok := enum.Next()
+		value, ok := iterator()
 		if !ok {
 			break
 		}
@@ -44,11 +46,13 @@ func Sum[T Numeric](enum Enumerable[T]) T {
 //	min, ok := Min(From(numbers))
 //	// min = 1, ok = true
 func Min[T Ordered](enum Enumerable[T]) (T, bool) {
+	iterator, sc := openEnumerable(enum) // Fresh iterator if possible
+	defer sc.close()
 	var minVal T
 	var found bool
 
 	for {
-		value, ok := enum.Next()
+		value, ok := iterator()
 		if !ok {
 			break
 		}
@@ -70,11 +74,13 @@ func Min[T Ordered](enum Enumerable[T]) (T, bool) {
 //	max, ok := Max(From(numbers))
 //	// max = 9, ok = true
 func Max[T Ordered](enum Enumerable[T]) (T, bool) {
+	iterator, sc := openEnumerable(enum) // Fresh iterator if possible
+	defer sc.close()
 	var maxVal T
 	var found bool
 
 	for {
-		value, ok := enum.Next()
+		value, ok := iterator()
 		if !ok {
 			break
 		}
@@ -86,3 +92,63 @@ func Max[T Ordered](enum Enumerable[T]) (T, bool) {
 
 	return maxVal, found
 }
+
+// MinBy returns the element with the minimum key extracted by keySelector.
+// If several elements share the minimum key, the first one is returned.
+// Returns zero value and false if Enumerable is empty.
+//
+// Example:
+//
+//	type Person struct { Age int; Name string }
+//	youngest, ok := MinBy(From(people), func(p Person) int { return p.Age })
+func MinBy[T any, K Ordered](enum Enumerable[T], keySelector func(T) K) (T, bool) {
+	iterator, sc := openEnumerable(enum) // Fresh iterator if possible
+	defer sc.close()
+	var minVal T
+	var minKey K
+	var found bool
+
+	for {
+		value, ok := iterator()
+		if !ok {
+			break
+		}
+		key := keySelector(value)
+		if !found || key < minKey {
+			minVal, minKey = value, key
+			found = true
+		}
+	}
+
+	return minVal, found
+}
+
+// MaxBy returns the element with the maximum key extracted by keySelector.
+// If several elements share the maximum key, the first one is returned.
+// Returns zero value and false if Enumerable is empty.
+//
+// Example:
+//
+//	type Person struct { Age int; Name string }
+//	oldest, ok := MaxBy(From(people), func(p Person) int { return p.Age })
+func MaxBy[T any, K Ordered](enum Enumerable[T], keySelector func(T) K) (T, bool) {
+	iterator, sc := openEnumerable(enum) // Fresh iterator if possible
+	defer sc.close()
+	var maxVal T
+	var maxKey K
+	var found bool
+
+	for {
+		value, ok := iterator()
+		if !ok {
+			break
+		}
+		key := keySelector(value)
+		if !found || key > maxKey {
+			maxVal, maxKey = value, key
+			found = true
+		}
+	}
+
+	return maxVal, found
+}
@@ -251,3 +251,41 @@ func TestMax(t *testing.T) {
 		}
 	})
 }
+
+func TestMinByMaxBy(t *testing.T) {
+	type Person struct {
+		Name string
+		Age  int
+	}
+	people := From([]Person{{"Alice", 30}, {"Bob", 25}, {"Carol", 35}, {"Dave", 25}, {"Eve", 35}})
+
+	t.Run("MinBy returns first minimum", func(t *testing.T) {
+		youngest, ok := MinBy(people, func(p Person) int { return p.Age })
+		if !ok || youngest.Name != "Bob" {
+			t.Errorf("Expected Bob, got %v (ok=%v)", youngest, ok)
+		}
+	})
+
+	t.Run("MaxBy returns first maximum", func(t *testing.T) {
+		oldest, ok := MaxBy(people, func(p Person) int { return p.Age })
+		if !ok || oldest.Name != "Carol" {
+			t.Errorf("Expected Carol, got %v (ok=%v)", oldest, ok)
+		}
+	})
+
+	t.Run("String keys", func(t *testing.T) {
+		last, ok := MaxBy(people, func(p Person) string { return p.Name })
+		if !ok || last.Name != "Eve" {
+			t.Errorf("Expected Eve, got %v (ok=%v)", last, ok)
+		}
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		if _, ok := MinBy(Empty[Person](), func(p Person) int { return p.Age }); ok {
+			t.Error("Expected false for empty stream")
+		}
+		if _, ok := MaxBy(Empty[Person](), func(p Person) int { return p.Age }); ok {
+			t.Error("Expected false for empty stream")
+		}
+	})
+}
@@ -7,8 +7,8 @@ import "sort"
 // SIZE: Loses size (unknown how many elements pass filter).
 func (s *stream[T]) Where(predicate func(T) bool) Stream[T] {
 	return &stream[T]{
-		sourceFactory: func() func() (T, bool) {
-			source := s.sourceFactory() // Get fresh source
+		sourceFactory: func(sc *scope) func() (T, bool) {
+			source := s.sourceFactory(sc) // Get fresh source
 			return func() (T, bool) {
 				for {
 					value, ok := source()
@@ -22,7 +22,8 @@ func (s *stream[T]) Where(predicate func(T) bool) Stream[T] {
 				}
 			}
 		},
-		size: -1, // LOSE: unknown how many pass filter
+		size:    -1, // LOSE: unknown how many pass filter
+		oneShot: s.oneShot,
 	}
 }
 
@@ -39,8 +40,8 @@ func (s *stream[T]) Where(predicate func(T) bool) Stream[T] {
 //	// []int{2, 4, 6}
 func (s *stream[T]) Select(mapper func(T) T) Stream[T] {
 	return &stream[T]{
-		sourceFactory: func() func() (T, bool) {
-			source := s.sourceFactory() // Get fresh source
+		sourceFactory: func(sc *scope) func() (T, bool) {
+			source := s.sourceFactory(sc) // Get fresh source
 			return func() (T, bool) {
 				value, ok := source()
 				if !ok {
@@ -50,7 +51,9 @@ func (s *stream[T]) Select(mapper func(T) T) Stream[T] {
 				return mapper(value), true
 			}
 		},
-		size: s.size, // PRESERVE: 1-to-1 transformation
+		size:    s.size, // PRESERVE: 1-to-1 transformation
+		at:      mapIndexed(s.at, func(value T, _ int) T { return mapper(value) }),
+		oneShot: s.oneShot,
 	}
 }
 
@@ -67,9 +70,9 @@ func (s *stream[T]) Select(mapper func(T) T) Stream[T] {
 //	// []int{0, 2, 6}
 func (s *stream[T]) SelectWithIndex(mapper func(T, int) T) Stream[T] {
 	return &stream[T]{
-		sourceFactory: func() func() (T, bool) {
-			source := s.sourceFactory() // Get fresh source
-			index := 0                  // Fresh counter
+		sourceFactory: func(sc *scope) func() (T, bool) {
+			source := s.sourceFactory(sc) // Get fresh source
+			index := 0                    // Fresh counter
 			return func() (T, bool) {
 				value, ok := source()
 				if !ok {
@@ -81,7 +84,9 @@ func (s *stream[T]) SelectWithIndex(mapper func(T, int) T) Stream[T] {
 				return result, true
 			}
 		},
-		size: s.size, // PRESERVE: 1-to-1 transformation
+		size:    s.size, // PRESERVE: 1-to-1 transformation
+		at:      mapIndexed(s.at, mapper),
+		oneShot: s.oneShot,
 	}
 }
 
@@ -98,16 +103,11 @@ func (s *stream[T]) SelectWithIndex(mapper func(T, int) T) Stream[T] {
 //	).ToSlice()
 //	// []string{"num_1", "num_2", "num_3"}
 func Select[T, R any](enum Enumerable[T], mapper func(T) R) Stream[R] {
-	size := -1
-	if sizable, ok := enum.(Sizable[T]); ok {
-		if s, known := sizable.Size(); known {
-			size = s
-		}
-	}
 	return &stream[R]{
-		sourceFactory: func() func() (R, bool) {
+		sourceFactory: func(sc *scope) func() (R, bool) {
+			source := iteratorOf(enum, sc) // Get fresh source
 			return func() (R, bool) {
-				value, ok := enum.Next()
+				value, ok := source()
 				if !ok {
 					var zero R
 					return zero, false
@@ -115,7 +115,9 @@ func Select[T, R any](enum Enumerable[T], mapper func(T) R) Stream[R] {
 				return mapper(value), true
 			}
 		},
-		size: size, // PRESERVE if possible
+		size:    sizeOf(enum), // PRESERVE if possible
+		at:      mapIndexed(indexOf(enum), func(value T, _ int) R { return mapper(value) }),
+		oneShot: isOneShot(enum),
 	}
 }
 
@@ -132,17 +134,12 @@ func Select[T, R any](enum Enumerable[T], mapper func(T) R) Stream[R] {
 //	).ToSlice()
 //	// []string{"num_1_at_0", "num_2_at_1", "num_3_at_2"}
 func SelectWithIndex[T, R any](enum Enumerable[T], mapper func(T, int) R) Stream[R] {
-	size := -1
-	if sizable, ok := enum.(Sizable[T]); ok {
-		if s, known := sizable.Size(); known {
-			size = s
-		}
-	}
 	return &stream[R]{
-		sourceFactory: func() func() (R, bool) {
-			index := 0 // Fresh counter
+		sourceFactory: func(sc *scope) func() (R, bool) {
+			source := iteratorOf(enum, sc) // Get fresh source
+			index := 0                     // Fresh counter
 			return func() (R, bool) {
-				value, ok := enum.Next()
+				value, ok := source()
 				if !ok {
 					var zero R
 					return zero, false
@@ -152,34 +149,75 @@ func SelectWithIndex[T, R any](enum Enumerable[T], mapper func(T, int) R) Stream
 				return result, true
 			}
 		},
-		size: size, // PRESERVE if possible
+		size:    sizeOf(enum), // PRESERVE if possible
+		at:      mapIndexed(indexOf(enum), mapper),
+		oneShot: isOneShot(enum),
+	}
+}
+
+// mapIndexed composes random access with a 1-to-1 mapper. Returns nil if at is nil.
+// The mapper is only invoked for the index being accessed.
+func mapIndexed[T, R any](at func(int) T, mapper func(T, int) R) func(int) R {
+	if at == nil {
+		return nil
+	}
+	return func(i int) R {
+		return mapper(at(i), i)
+	}
+}
+
+// Scan applies an accumulator function over the Enumerable and emits every intermediate
+// accumulator value (a running aggregate). The seed itself is not emitted.
+// This is a function (not a method) because in Go methods cannot have their own type parameters.
+//
+// SIZE: Preserves size if source is Sizable (one accumulator per element).
+//
+// Example:
+//
+//	runningTotal := Scan(
+//	    From([]int{1, 2, 3, 4}),
+//	    0,
+//	    func(acc, x int) int { return acc + x },
+//	).ToSlice()
+//	// [1, 3, 6, 10]
+func Scan[T, A any](enum Enumerable[T], seed A, accumulator func(A, T) A) Stream[A] {
+	return &stream[A]{
+		sourceFactory: func(sc *scope) func() (A, bool) {
+			source := iteratorOf(enum, sc) // Get fresh source
+			acc := seed                    // Fresh accumulator
+			return func() (A, bool) {
+				value, ok := source()
+				if !ok {
+					var zero A
+					return zero, false
+				}
+				acc = accumulator(acc, value)
+				return acc, true
+			}
+		},
+		size:    sizeOf(enum), // PRESERVE if possible
+		oneShot: isOneShot(enum),
 	}
 }
 
 // Take takes the first n elements from Stream.
 //
-// SIZE: Calculated as min(sourceSize, n) if source size known, else n.
+// SIZE: Calculated as min(sourceSize, n) if source size known, else unknown.
 // If n is negative, returns an empty Stream.
 func (s *stream[T]) Take(n int) Stream[T] {
 	if n < 0 {
 		return Empty[T]()
 	}
 
-	var newSize int
+	newSize := -1 // n is only an upper bound: the source may hold fewer elements
 	if s.size != -1 {
-		if s.size < n {
-			newSize = s.size
-		} else {
-			newSize = n
-		}
-	} else {
-		newSize = n // Don't know source size, but result won't exceed n
+		newSize = min(s.size, n)
 	}
 
 	return &stream[T]{
-		sourceFactory: func() func() (T, bool) {
-			source := s.sourceFactory() // Get fresh source
-			count := 0                  // Fresh counter
+		sourceFactory: func(sc *scope) func() (T, bool) {
+			source := s.sourceFactory(sc) // Get fresh source
+			count := 0                    // Fresh counter
 			return func() (T, bool) {
 				if count >= n {
 					var zero T
@@ -194,7 +232,9 @@ func (s *stream[T]) Take(n int) Stream[T] {
 				return value, true
 			}
 		},
-		size: newSize,
+		size:    newSize,
+		at:      s.at, // PRESERVE: a prefix keeps its indexes
+		oneShot: s.oneShot,
 	}
 }
 
@@ -202,6 +242,8 @@ func (s *stream[T]) Take(n int) Stream[T] {
 //
 // SIZE: Calculated as max(0, sourceSize - n) if source size known, else unknown.
 // If n is negative, treats it as 0 (no skipping).
+//
+// OPTIMIZATION: If the source is indexable (slices, Range), skipping is O(1).
 func (s *stream[T]) Skip(n int) Stream[T] {
 	if n < 0 {
 		n = 0
@@ -222,10 +264,24 @@ func (s *stream[T]) Skip(n int) Stream[T] {
 	}
 	// else: -1 (unknown)
 
+	// OPTIMIZATION: Indexable source - start directly at index n
+	if s.at != nil {
+		at := s.at
+		shifted := func(i int) T { return at(i + n) }
+		return &stream[T]{
+			sourceFactory: func(sc *scope) func() (T, bool) {
+				return indexedIterator(shifted, newSize)
+			},
+			size:    newSize,
+			at:      shifted,
+			oneShot: s.oneShot,
+		}
+	}
+
 	return &stream[T]{
-		sourceFactory: func() func() (T, bool) {
-			source := s.sourceFactory() // Get fresh source
-			skipped := 0                // Fresh counter
+		sourceFactory: func(sc *scope) func() (T, bool) {
+			source := s.sourceFactory(sc) // Get fresh source
+			skipped := 0                  // Fresh counter
 			return func() (T, bool) {
 				for skipped < n {
 					_, ok := source()
@@ -238,7 +294,8 @@ func (s *stream[T]) Skip(n int) Stream[T] {
 				return source()
 			}
 		},
-		size: newSize,
+		size:    newSize,
+		oneShot: s.oneShot,
 	}
 }
 
@@ -248,9 +305,9 @@ func (s *stream[T]) Skip(n int) Stream[T] {
 // SIZE: Loses size (unknown how many elements satisfy predicate).
 func (s *stream[T]) TakeWhile(predicate func(T) bool) Stream[T] {
 	return &stream[T]{
-		sourceFactory: func() func() (T, bool) {
-			source := s.sourceFactory() // Get fresh source
-			stopped := false            // Flag to stop iteration
+		sourceFactory: func(sc *scope) func() (T, bool) {
+			source := s.sourceFactory(sc) // Get fresh source
+			stopped := false              // Flag to stop iteration
 
 			return func() (T, bool) {
 				if stopped {
@@ -273,7 +330,8 @@ func (s *stream[T]) TakeWhile(predicate func(T) bool) Stream[T] {
 				return value, true
 			}
 		},
-		size: -1, // LOSE: unknown how many satisfy predicate
+		size:    -1, // LOSE: unknown how many satisfy predicate
+		oneShot: s.oneShot,
 	}
 }
 
@@ -283,9 +341,9 @@ func (s *stream[T]) TakeWhile(predicate func(T) bool) Stream[T] {
 // SIZE: Loses size (unknown how many elements to skip).
 func (s *stream[T]) SkipWhile(predicate func(T) bool) Stream[T] {
 	return &stream[T]{
-		sourceFactory: func() func() (T, bool) {
-			source := s.sourceFactory() // Get fresh source
-			skipping := true            // Flag to continue skipping
+		sourceFactory: func(sc *scope) func() (T, bool) {
+			source := s.sourceFactory(sc) // Get fresh source
+			skipping := true              // Flag to continue skipping
 
 			return func() (T, bool) {
 				for skipping {
@@ -305,7 +363,8 @@ func (s *stream[T]) SkipWhile(predicate func(T) bool) Stream[T] {
 				return source()
 			}
 		},
-		size: -1, // LOSE: unknown how many to skip
+		size:    -1, // LOSE: unknown how many to skip
+		oneShot: s.oneShot,
 	}
 }
 
@@ -341,91 +400,104 @@ func heapifyDown[T any](items []T, i, n int, less func(a, b T) bool) {
 }
 
 // TakeOrderedBy returns the first n elements ordered by the less function.
-// Uses a heap-based algorithm for efficient processing.
+// Uses a heap-based algorithm for efficient processing. Ties keep source order.
 //
-// SIZE: Calculated as min(sourceSize, n) if source size known, else n.
+// SIZE: Calculated as min(sourceSize, n) if source size known, else unknown.
 //
 // Example:
 //
 //	numbers := []int{5, 2, 8, 1, 9, 3}
 //	top3 := TakeOrderedBy(From(numbers), 3, func(a, b int) bool { return a < b })
 //	// Returns the 3 smallest elements: [1, 2, 3]
-//
-//nolint:gocognit
 func TakeOrderedBy[T any](enum Enumerable[T], n int, less func(a, b T) bool) Stream[T] {
 	if n <= 0 {
 		return Empty[T]()
 	}
 
-	var size int
-	if sizable, ok := enum.(Sizable[T]); ok {
-		if s, known := sizable.Size(); known {
-			if s < n {
-				size = s
-			} else {
-				size = n
-			}
-		} else {
-			size = n // Don't know source size, but result won't exceed n
-		}
-	} else {
-		size = n // Don't know source size, but result won't exceed n
+	size := sizeOf(enum) // n is only an upper bound: the source may hold fewer elements
+	if size != -1 {
+		size = min(size, n)
 	}
 
 	return &stream[T]{
-		sourceFactory: func() func() (T, bool) {
-			var heap []T
+		sourceFactory: func(sc *scope) func() (T, bool) {
+			return deferredIterator(func() []T {
+				return takeOrdered(iteratorOf(enum, sc), n, func(a, b T) int {
+					if less(a, b) {
+						return -1
+					}
+					if less(b, a) {
+						return 1
+					}
+					return 0
+				})
+			})
+		},
+		size:    size,
+		oneShot: isOneShot(enum),
+	}
+}
 
-			// Collect first n elements
-			for i := 0; i < n; i++ {
-				val, ok := enum.Next()
-				if !ok {
-					break
-				}
-				heap = append(heap, val)
-			}
+// takeOrdered returns the n smallest elements of source in ascending order of compare.
+// It keeps a bounded max-heap of candidates; ties are broken by source position,
+// so the result matches the first n elements of a stable sort.
+func takeOrdered[T any](source func() (T, bool), n int, compare func(T, T) int) []T {
+	type candidate struct {
+		value T
+		index int
+	}
+	before := func(a, b candidate) bool {
+		if c := compare(a.value, b.value); c != 0 {
+			return c < 0
+		}
+		return a.index < b.index
+	}
 
-			if len(heap) == 0 {
-				var zero T
-				return func() (T, bool) {
-					return zero, false
-				}
-			}
+	var heap []candidate
+	index := 0
 
-			// Build max-heap
-			buildHeap(heap, func(a, b T) bool { return !less(a, b) })
+	// Collect first n elements
+	for len(heap) < n {
+		val, ok := source()
+		if !ok {
+			break
+		}
+		heap = append(heap, candidate{value: val, index: index})
+		index++
+	}
 
-			// Process remaining elements
-			for {
-				val, ok := enum.Next()
-				if !ok {
-					break
-				}
+	if len(heap) == 0 {
+		return nil
+	}
 
-				if less(val, heap[0]) {
-					heap[0] = val
-					heapifyDown(heap, 0, len(heap), func(a, b T) bool { return !less(a, b) })
-				}
-			}
+	// Build max-heap: the root is the largest candidate kept so far
+	buildHeap(heap, before)
 
-			// Sort the result
-			sort.Slice(heap, func(i, j int) bool {
-				return less(heap[i], heap[j])
-			})
+	// Process remaining elements
+	for {
+		val, ok := source()
+		if !ok {
+			break
+		}
 
-			index := 0
-			return func() (T, bool) {
-				if index >= len(heap) {
-					var zero T
-					return zero, false
-				}
-				result := heap[index]
-				index++
-				return result, true
-			}
-		},
-		size: size,
+		next := candidate{value: val, index: index}
+		index++
+		if before(next, heap[0]) {
+			heap[0] = next
+			heapifyDown(heap, 0, len(heap), before)
+		}
 	}
+
+	// Sort the result
+	sort.Slice(heap, func(i, j int) bool {
+		return before(heap[i], heap[j])
+	})
+
+	result := make([]T, len(heap))
+	for i, c := range heap {
+		result[i] = c.value
+	}
+	return result
 }
 
 // TakeOrderedDescendingBy returns the first n elements ordered in descending order by the less function.
@@ -437,19 +509,43 @@ func TakeOrderedBy[T any](enum Enumerable[T], n int, less func(a, b T) bool) Str
 //	top3 := TakeOrderedDescendingBy(From(numbers), 3, func(a, b int) bool { return a < b })
 //	// Returns the 3 largest elements: [9, 8, 5]
 func TakeOrderedDescendingBy[T any](enum Enumerable[T], n int, less func(a, b T) bool) Stream[T] {
-	return TakeOrderedBy(enum, n, func(a, b T) bool { return !less(a, b) })
+	return TakeOrderedBy(enum, n, func(a, b T) bool { return less(b, a) })
 }
 
 // Reverse reverses the order of elements in the Stream.
-// NOTE: Reverse materializes the entire stream (partially lazy).
+// NOTE: Reverse materializes the entire stream on the first Next() of each iteration (partially lazy).
 //
-// SIZE: Preserves size (1-to-1 transformation, materializes).
+// OPTIMIZATION: If the source is indexable (slices, Range), elements are read backwards
+// by index without materializing.
+//
+// SIZE: Preserves size (1-to-1 transformation).
 func (s *stream[T]) Reverse() Stream[T] {
-	items := s.ToSlice()
-	for i, j := 0, len(items)-1; i < j; i, j = i+1, j-1 {
-		items[i], items[j] = items[j], items[i]
+	if s.at != nil {
+		at, size := s.at, s.size
+		reversed := func(i int) T { return at(size - 1 - i) }
+		return &stream[T]{
+			sourceFactory: func(sc *scope) func() (T, bool) {
+				return indexedIterator(reversed, size)
+			},
+			size:    size,
+			at:      reversed,
+			oneShot: s.oneShot,
+		}
+	}
+
+	return &stream[T]{
+		sourceFactory: func(sc *scope) func() (T, bool) {
+			return deferredIterator(func() []T {
+				items := s.ToSlice()
+				for i, j := 0, len(items)-1; i < j; i, j = i+1, j-1 {
+					items[i], items[j] = items[j], items[i]
+				}
+				return items
+			})
+		},
+		size:    s.size, // PRESERVE: 1-to-1 transformation
+		oneShot: s.oneShot,
 	}
-	return From(items) // From preserves size
 }
 
 // SelectMany transforms each element into a sequence and flattens the resulting sequences.
@@ -469,35 +565,48 @@ func (s *stream[T]) Reverse() Stream[T] {
 //nolint:gocognit
 func SelectMany[T, R any](enum Enumerable[T], selector func(T) Enumerable[R]) Stream[R] {
 	return &stream[R]{
-		sourceFactory: func() func() (R, bool) {
-			var currentEnum Enumerable[R]
+		sourceFactory: func(sc *scope) func() (R, bool) {
+			source := iteratorOf(enum, sc) // Get fresh source
+			var current func() (R, bool)
 			var hasCurrent bool
 
+			// Each inner stream gets its own scope, released as soon as it is exhausted,
+			// so the outer scope does not collect a release function per element
+			var currentScope *scope
+			sc.onClose(func() {
+				if currentScope != nil {
+					currentScope.close()
+				}
+			})
+
 			return func() (R, bool) {
 				for {
 					// If we have a current enumerable, try to get next element from it
 					if hasCurrent {
-						val, ok := currentEnum.Next()
+						val, ok := current()
 						if ok {
 							return val, true
 						}
 						// Current enumerable exhausted, move to next
 						hasCurrent = false
+						currentScope.close()
 					}
 
 					// Get next element from source
-					elem, ok := enum.Next()
+					elem, ok := source()
 					if !ok {
 						var zero R
 						return zero, false
 					}
 
 					// Transform element into enumerable
-					currentEnum = selector(elem)
+					currentScope = &scope{}
+					current = iteratorOf(selector(elem), currentScope)
 					hasCurrent = true
 				}
 			}
 		},
-		size: -1, // LOSE: 1-to-many transformation
+		size:    -1, // LOSE: 1-to-many transformation
+		oneShot: isOneShot(enum),
 	}
 }
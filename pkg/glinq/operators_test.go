@@ -351,3 +351,35 @@ func TestSelectMany(t *testing.T) {
 		}
 	})
 }
+
+func TestScan(t *testing.T) {
+	t.Run("Running total", func(t *testing.T) {
+		s := Scan(From([]int{1, 2, 3, 4}), 0, func(acc, x int) int { return acc + x })
+		assertSize(t, s, 4, "Scan")
+		assertReiterable(t, "Scan", s, []int{1, 3, 6, 10})
+	})
+
+	t.Run("Type-changing accumulator", func(t *testing.T) {
+		result := Scan(From([]string{"a", "b", "c"}), "", func(acc string, s string) string {
+			return acc + s
+		}).ToSlice()
+
+		expected := []string{"a", "ab", "abc"}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("Expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("Lazy over infinite source", func(t *testing.T) {
+		result := Scan(RepeatForever(1), 0, func(acc, x int) int { return acc + x }).Take(3).ToSlice()
+		if expected := []int{1, 2, 3}; !reflect.DeepEqual(result, expected) {
+			t.Errorf("Expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		if Scan(Empty[int](), 0, func(acc, x int) int { return acc + x }).Any() {
+			t.Error("Expected empty result")
+		}
+	})
+}
@@ -1,43 +1,174 @@
 package glinq
 
-import "sort"
+import (
+	"cmp"
+	"slices"
+)
 
-// orderBy is a common sorting function used by OrderBy and OrderByDescending.
-func (s *stream[T]) orderBy(ascending bool, comparator func(T, T) int) Stream[T] {
-	sorted := s.ToSlice()
+// OrderedStream is a sorted Stream that accepts additional, lower-priority sort keys.
+// It is returned by OrderBy, OrderByDescending and the OrderByKey functions.
+// All sorting is stable: elements that compare equal on every key keep their source order.
+type OrderedStream[T any] interface {
+	Stream[T]
+	// ThenBy performs a subsequent ascending ordering of elements that are equal
+	// under all previous comparators.
+	//
+	// Example:
+	//   sorted := From(people).
+	//       OrderBy(func(a, b Person) int { return a.Age - b.Age }).
+	//       ThenBy(func(a, b Person) int { return strings.Compare(a.Name, b.Name) }).
+	//       ToSlice()
+	ThenBy(comparator func(T, T) int) OrderedStream[T]
+	// ThenByDescending performs a subsequent descending ordering of elements that are equal
+	// under all previous comparators.
+	ThenByDescending(comparator func(T, T) int) OrderedStream[T]
+}
 
-	sort.Slice(sorted, func(i, j int) bool {
-		cmp := comparator(sorted[i], sorted[j])
-		if ascending {
-			return cmp < 0
-		}
-		return cmp > 0
-	})
+// orderedStream represents the internal implementation of OrderedStream.
+// It keeps the unsorted source and the comparator chain so further keys can be added.
+type orderedStream[T any] struct {
+	*stream[T]
+	source      *stream[T]
+	comparators []func(T, T) int // In priority order, descending keys already inverted
+}
 
-	// SIZE: Preserves size (1-to-1 transformation, materializes)
-	return &stream[T]{
-		sourceFactory: func() func() (T, bool) {
-			index := 0 // Fresh index for each iterator
-			return func() (T, bool) {
-				if index >= len(sorted) {
-					var zero T
-					return zero, false
-				}
-				result := sorted[index]
-				index++
-				return result, true
-			}
+// newOrderedStream returns a Stream that sorts source by the comparator chain.
+// NOTE: Sorting materializes the source on the first Next() of each iteration (partially lazy).
+func newOrderedStream[T any](source *stream[T], comparators []func(T, T) int) *orderedStream[T] {
+	compare := composeComparators(comparators)
+
+	return &orderedStream[T]{
+		stream: &stream[T]{
+			sourceFactory: func(sc *scope) func() (T, bool) {
+				return deferredIterator(func() []T {
+					sorted := source.ToSlice() // Fresh pass over source
+					slices.SortStableFunc(sorted, compare)
+					return sorted
+				})
+			},
+			size:    source.size, // PRESERVE: 1-to-1 transformation
+			oneShot: source.oneShot,
 		},
-		size: len(sorted), // PRESERVE: 1-to-1 transformation
+		source:      source,
+		comparators: comparators,
+	}
+}
+
+// composeComparators combines comparators into one that consults them in order
+// until one reports a difference.
+func composeComparators[T any](comparators []func(T, T) int) func(T, T) int {
+	return func(a, b T) int {
+		for _, comparator := range comparators {
+			if c := comparator(a, b); c != 0 {
+				return c
+			}
+		}
+		return 0
+	}
+}
+
+// descending inverts a comparator.
+func descending[T any](comparator func(T, T) int) func(T, T) int {
+	return func(a, b T) int {
+		return comparator(b, a)
+	}
+}
+
+// byKey builds a comparator from a key selector.
+func byKey[T any, K Ordered](keySelector func(T) K) func(T, T) int {
+	return func(a, b T) int {
+		return cmp.Compare(keySelector(a), keySelector(b))
 	}
 }
 
 // OrderBy sorts elements in ascending order.
-func (s *stream[T]) OrderBy(comparator func(T, T) int) Stream[T] {
-	return s.orderBy(true, comparator)
+// The sort is stable.
+func (s *stream[T]) OrderBy(comparator func(T, T) int) OrderedStream[T] {
+	return newOrderedStream(s, []func(T, T) int{comparator})
 }
 
 // OrderByDescending sorts elements in descending order.
-func (s *stream[T]) OrderByDescending(comparator func(T, T) int) Stream[T] {
-	return s.orderBy(false, comparator)
+// The sort is stable.
+func (s *stream[T]) OrderByDescending(comparator func(T, T) int) OrderedStream[T] {
+	return newOrderedStream(s, []func(T, T) int{descending(comparator)})
+}
+
+// ThenBy adds an ascending secondary comparator.
+func (o *orderedStream[T]) ThenBy(comparator func(T, T) int) OrderedStream[T] {
+	return newOrderedStream(o.source, o.withComparator(comparator))
+}
+
+// ThenByDescending adds a descending secondary comparator.
+func (o *orderedStream[T]) ThenByDescending(comparator func(T, T) int) OrderedStream[T] {
+	return newOrderedStream(o.source, o.withComparator(descending(comparator)))
+}
+
+// Take takes the first n elements in sorted order.
+// OPTIMIZATION: Fuses with the sort: keeps only n candidates in a heap (see TakeOrderedBy)
+// instead of sorting the whole source. The result is identical to sorting and then taking.
+//
+// SIZE: Calculated as min(sourceSize, n) if source size known, else unknown.
+func (o *orderedStream[T]) Take(n int) Stream[T] {
+	if n <= 0 {
+		return Empty[T]()
+	}
+
+	size := o.source.size // n is only an upper bound when the source size is unknown
+	if size != -1 {
+		size = min(size, n)
+	}
+	compare := composeComparators(o.comparators)
+
+	return &stream[T]{
+		sourceFactory: func(sc *scope) func() (T, bool) {
+			return deferredIterator(func() []T {
+				return takeOrdered(o.source.sourceFactory(sc), n, compare)
+			})
+		},
+		size:    size,
+		oneShot: o.source.oneShot,
+	}
+}
+
+// withComparator returns a copy of the comparator chain extended with comparator.
+func (o *orderedStream[T]) withComparator(comparator func(T, T) int) []func(T, T) int {
+	comparators := make([]func(T, T) int, len(o.comparators), len(o.comparators)+1)
+	copy(comparators, o.comparators)
+	return append(comparators, comparator)
+}
+
+// OrderByKey sorts elements in ascending order of the key extracted by keySelector.
+// This is a function (not a method) because in Go methods cannot have their own type parameters.
+// The sort is stable.
+//
+// Example:
+//
+//	type Person struct { Name string; Age int }
+//	sorted := OrderByKey(From(people), func(p Person) int { return p.Age }).ToSlice()
+func OrderByKey[T any, K Ordered](enum Enumerable[T], keySelector func(T) K) OrderedStream[T] {
+	return FromEnumerable(enum).OrderBy(byKey(keySelector))
+}
+
+// OrderByKeyDescending sorts elements in descending order of the key extracted by keySelector.
+// This is a function (not a method) because in Go methods cannot have their own type parameters.
+// The sort is stable.
+func OrderByKeyDescending[T any, K Ordered](enum Enumerable[T], keySelector func(T) K) OrderedStream[T] {
+	return FromEnumerable(enum).OrderByDescending(byKey(keySelector))
+}
+
+// ThenByKey adds an ascending secondary ordering by the key extracted by keySelector.
+//
+// Example:
+//
+//	sorted := ThenByKey(
+//	    OrderByKey(From(people), func(p Person) int { return p.Age }),
+//	    func(p Person) string { return p.Name },
+//	).ToSlice()
+func ThenByKey[T any, K Ordered](ordered OrderedStream[T], keySelector func(T) K) OrderedStream[T] {
+	return ordered.ThenBy(byKey(keySelector))
+}
+
+// ThenByKeyDescending adds a descending secondary ordering by the key extracted by keySelector.
+func ThenByKeyDescending[T any, K Ordered](ordered OrderedStream[T], keySelector func(T) K) OrderedStream[T] {
+	return ordered.ThenByDescending(byKey(keySelector))
 }
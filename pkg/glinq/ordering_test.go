@@ -2,6 +2,7 @@ package glinq
 
 import (
 	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -65,3 +66,204 @@ func TestOrderByDescending(t *testing.T) {
 		t.Errorf("Expected %v, got %v", expected, result)
 	}
 }
+
+type sortPerson struct {
+	Name string
+	Age  int
+}
+
+var sortPeople = []sortPerson{
+	{"Dave", 30},
+	{"Alice", 25},
+	{"Carol", 30},
+	{"Bob", 25},
+	{"Eve", 35},
+}
+
+func TestOrderByStable(t *testing.T) {
+	result := From(sortPeople).
+		OrderBy(func(a, b sortPerson) int { return a.Age - b.Age }).
+		ToSlice()
+
+	// Equal ages keep their source order
+	expected := []sortPerson{{"Alice", 25}, {"Bob", 25}, {"Dave", 30}, {"Carol", 30}, {"Eve", 35}}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+
+	result = From(sortPeople).
+		OrderByDescending(func(a, b sortPerson) int { return a.Age - b.Age }).
+		ToSlice()
+
+	expected = []sortPerson{{"Eve", 35}, {"Dave", 30}, {"Carol", 30}, {"Alice", 25}, {"Bob", 25}}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+}
+
+func TestThenBy(t *testing.T) {
+	byAge := func(a, b sortPerson) int { return a.Age - b.Age }
+	byName := func(a, b sortPerson) int { return strings.Compare(a.Name, b.Name) }
+
+	t.Run("ThenBy", func(t *testing.T) {
+		result := From(sortPeople).OrderByDescending(byAge).ThenBy(byName).ToSlice()
+
+		expected := []sortPerson{{"Eve", 35}, {"Carol", 30}, {"Dave", 30}, {"Alice", 25}, {"Bob", 25}}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("Expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("ThenByDescending", func(t *testing.T) {
+		result := From(sortPeople).OrderBy(byAge).ThenByDescending(byName).ToSlice()
+
+		expected := []sortPerson{{"Bob", 25}, {"Alice", 25}, {"Dave", 30}, {"Carol", 30}, {"Eve", 35}}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("Expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("Branching chains are independent", func(t *testing.T) {
+		base := From(sortPeople).OrderBy(byAge)
+		asc := base.ThenBy(byName).ToSlice()
+		desc := base.ThenByDescending(byName).ToSlice()
+		if asc[0].Name != "Alice" || desc[0].Name != "Bob" {
+			t.Errorf("Unexpected results: %v, %v", asc, desc)
+		}
+	})
+
+	t.Run("Preserves size", func(t *testing.T) {
+		s := From([]int{3, 1, 2}).OrderBy(func(a, b int) int { return a - b }).ThenBy(func(a, b int) int { return 0 })
+		assertSize(t, s, 3, "ThenBy")
+	})
+}
+
+func TestOrderByKey(t *testing.T) {
+	t.Run("OrderByKey with ThenByKey", func(t *testing.T) {
+		result := ThenByKey(
+			OrderByKey(From(sortPeople), func(p sortPerson) int { return p.Age }),
+			func(p sortPerson) string { return p.Name },
+		).ToSlice()
+
+		expected := []sortPerson{{"Alice", 25}, {"Bob", 25}, {"Carol", 30}, {"Dave", 30}, {"Eve", 35}}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("Expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("OrderByKeyDescending with ThenByKeyDescending", func(t *testing.T) {
+		result := ThenByKeyDescending(
+			OrderByKeyDescending(From(sortPeople), func(p sortPerson) int { return p.Age }),
+			func(p sortPerson) string { return p.Name },
+		).ToSlice()
+
+		expected := []sortPerson{{"Eve", 35}, {"Dave", 30}, {"Carol", 30}, {"Bob", 25}, {"Alice", 25}}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("Expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("String keys", func(t *testing.T) {
+		result := OrderByKey(From([]string{"pear", "fig", "apple"}), func(s string) string { return s }).ToSlice()
+
+		expected := []string{"apple", "fig", "pear"}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("Expected %v, got %v", expected, result)
+		}
+	})
+}
+
+func TestOrderByLazy(t *testing.T) {
+	t.Run("Construction does not consume source", func(t *testing.T) {
+		pulled := 0
+		source := From([]int{3, 1, 2}).Select(func(x int) int {
+			pulled++
+			return x
+		})
+		sorted := source.OrderBy(func(a, b int) int { return a - b })
+		if pulled != 0 {
+			t.Errorf("Expected no elements pulled before iteration, got %d", pulled)
+		}
+		sorted.ToSlice()
+		if pulled != 3 {
+			t.Errorf("Expected 3 elements pulled, got %d", pulled)
+		}
+	})
+
+	t.Run("Re-iteration sees upstream changes", func(t *testing.T) {
+		data := []int{3, 1, 2}
+		sorted := From(data).OrderBy(func(a, b int) int { return a - b })
+		first := sorted.ToSlice()
+		data[0] = 0
+		second := sorted.ToSlice()
+
+		if !reflect.DeepEqual(first, []int{1, 2, 3}) || !reflect.DeepEqual(second, []int{0, 1, 2}) {
+			t.Errorf("Unexpected results: %v, %v", first, second)
+		}
+	})
+
+	t.Run("Reverse is lazy", func(t *testing.T) {
+		data := []int{1, 2, 3}
+		reversed := From(data).Reverse()
+		data[2] = 9
+
+		expected := []int{9, 2, 1}
+		if result := reversed.ToSlice(); !reflect.DeepEqual(result, expected) {
+			t.Errorf("Expected %v, got %v", expected, result)
+		}
+		if result := reversed.ToSlice(); !reflect.DeepEqual(result, expected) {
+			t.Errorf("Expected %v on second iteration, got %v", expected, result)
+		}
+	})
+}
+
+func TestOrderByTake(t *testing.T) {
+	byAge := func(a, b sortPerson) int { return a.Age - b.Age }
+
+	t.Run("Matches full sort with ties", func(t *testing.T) {
+		for n := 0; n <= len(sortPeople)+1; n++ {
+			fused := From(sortPeople).OrderBy(byAge).Take(n).ToSlice()
+			full := From(sortPeople).OrderBy(byAge).ToSlice()
+			if n < len(full) {
+				full = full[:n]
+			}
+			if len(fused) != len(full) || (len(full) > 0 && !reflect.DeepEqual(fused, full)) {
+				t.Errorf("Take(%d): expected %v, got %v", n, full, fused)
+			}
+		}
+	})
+
+	t.Run("Descending with ThenBy", func(t *testing.T) {
+		result := From(sortPeople).
+			OrderByDescending(byAge).
+			ThenBy(func(a, b sortPerson) int { return strings.Compare(a.Name, b.Name) }).
+			Take(3).
+			ToSlice()
+
+		expected := []sortPerson{{"Eve", 35}, {"Carol", 30}, {"Dave", 30}}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("Expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("Size", func(t *testing.T) {
+		s := From([]int{5, 4, 3}).OrderBy(func(a, b int) int { return a - b })
+		assertSize(t, s.Take(2), 2, "OrderBy.Take")
+		assertSize(t, s.Take(10), 3, "OrderBy.Take (larger than source)")
+	})
+}
+
+func TestTakeOrderedBy(t *testing.T) {
+	less := func(a, b int) bool { return a < b }
+	numbers := []int{5, 2, 8, 1, 9, 3}
+
+	result := TakeOrderedBy(From(numbers), 3, less).ToSlice()
+	if expected := []int{1, 2, 3}; !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+
+	result = TakeOrderedDescendingBy(From(numbers), 3, less).ToSlice()
+	if expected := []int{9, 8, 5}; !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+}
@@ -0,0 +1,367 @@
+package glinq
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// parallelBatchPerWorker is the number of elements pulled per worker before a batch is processed.
+const parallelBatchPerWorker = 256
+
+// ParallelOption configures the parallel operators.
+type ParallelOption func(*parallelConfig)
+
+// parallelConfig holds the settings for the parallel operators.
+type parallelConfig struct {
+	workers int  // number of goroutines processing a batch
+	ordered bool // whether results keep source order
+}
+
+// WithWorkers sets the number of worker goroutines.
+// Values less than 1 fall back to the default (runtime.GOMAXPROCS(0)).
+func WithWorkers(n int) ParallelOption {
+	return func(c *parallelConfig) {
+		if n > 0 {
+			c.workers = n
+		}
+	}
+}
+
+// Unordered emits results in completion order instead of source order.
+// Results are streamed as soon as they are computed, so a slow element does not hold back
+// the results of the other elements of its batch. The next batch is still read only after
+// every element of the current batch has been processed.
+func Unordered() ParallelOption {
+	return func(c *parallelConfig) {
+		c.ordered = false
+	}
+}
+
+func newParallelConfig(opts []ParallelOption) parallelConfig {
+	cfg := parallelConfig{
+		workers: runtime.GOMAXPROCS(0),
+		ordered: true,
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return cfg
+}
+
+// ParallelSelect transforms elements to a different type using a bounded pool of goroutines.
+// The source is read sequentially in batches; each batch is mapped concurrently and fully
+// processed before the next one is read, so no goroutines outlive an iteration.
+// Results keep source order, and are emitted once their batch is done, unless the Unordered
+// option is given; then they are emitted in completion order as soon as they are ready.
+//
+// The mapper must be safe for concurrent use.
+//
+// SIZE: Preserves size if source is Sizable (1-to-1 transformation).
+//
+// Example:
+//
+//	hashes := ParallelSelect(
+//	    From(files),
+//	    func(f string) string { return checksum(f) },
+//	    WithWorkers(8),
+//	).ToSlice()
+func ParallelSelect[T, R any](enum Enumerable[T], mapper func(T) R, opts ...ParallelOption) Stream[R] {
+	cfg := newParallelConfig(opts)
+
+	return &stream[R]{
+		sourceFactory: func(sc *scope) func() (R, bool) {
+			return parallelIterator(iteratorOf(enum, sc), sc, sizeOf(enum), cfg, func(value T) (R, bool) {
+				return mapper(value), true
+			})
+		},
+		size:    sizeOf(enum), // PRESERVE if possible
+		oneShot: isOneShot(enum),
+	}
+}
+
+// ParallelWhere filters elements by predicate using a bounded pool of goroutines.
+// Batching and ordering behave as in ParallelSelect.
+//
+// The predicate must be safe for concurrent use.
+//
+// SIZE: Loses size (unknown how many elements pass filter).
+//
+// Example:
+//
+//	primes := ParallelWhere(Range(1, 1_000_000), isPrime).ToSlice()
+func ParallelWhere[T any](enum Enumerable[T], predicate func(T) bool, opts ...ParallelOption) Stream[T] {
+	cfg := newParallelConfig(opts)
+
+	return &stream[T]{
+		sourceFactory: func(sc *scope) func() (T, bool) {
+			return parallelIterator(iteratorOf(enum, sc), sc, sizeOf(enum), cfg, func(value T) (T, bool) {
+				return value, predicate(value)
+			})
+		},
+		size:    -1, // LOSE: unknown how many pass filter
+		oneShot: isOneShot(enum),
+	}
+}
+
+// ParallelForEach executes an action for each element using a bounded pool of goroutines.
+// It returns once every element has been processed. If an action panics, no further
+// elements are started and the panic is re-raised on the calling goroutine once the running actions finish.
+//
+// The action must be safe for concurrent use.
+//
+// Example:
+//
+//	ParallelForEach(From(urls), func(u string) { fetch(u) }, WithWorkers(16))
+func ParallelForEach[T any](enum Enumerable[T], action func(T), opts ...ParallelOption) {
+	cfg := newParallelConfig(opts)
+	cfg.ordered = false // No results to order
+
+	source, sc := openEnumerable(enum)
+	defer sc.close()
+	iterator := parallelIterator(source, sc, sizeOf(enum), cfg, func(value T) (struct{}, bool) {
+		action(value)
+		return struct{}{}, false
+	})
+	iterator() // Drains the source: fn never keeps a result
+}
+
+// parallelIterator returns an iterator that reads the source in batches and applies fn
+// to each batch concurrently. fn returns the mapped value and whether to emit it.
+// size is the source size hint (-1 if unknown), used to bound batch allocations.
+// Workers still running when sc is closed are stopped before the scope is released.
+//
+//nolint:gocognit
+func parallelIterator[T, R any](
+	source func() (T, bool),
+	sc *scope,
+	size int,
+	cfg parallelConfig,
+	fn func(T) (R, bool),
+) func() (R, bool) {
+	batchSize := cfg.workers * parallelBatchPerWorker
+	if size != -1 && size < batchSize {
+		batchSize = size
+	}
+	if batchSize < 1 {
+		batchSize = 1
+	}
+
+	if !cfg.ordered {
+		return unorderedParallelIterator(source, sc, batchSize, cfg.workers, fn)
+	}
+
+	var results []R
+	index := 0
+	exhausted := false
+
+	return func() (R, bool) {
+		for index >= len(results) {
+			if exhausted {
+				var zero R
+				return zero, false
+			}
+
+			// Read the next batch sequentially: sources are not thread-safe
+			batch := make([]T, 0, batchSize)
+			for len(batch) < batchSize {
+				value, ok := source()
+				if !ok {
+					exhausted = true
+					break
+				}
+				batch = append(batch, value)
+			}
+
+			results = runParallelBatch(batch, cfg.workers, fn)
+			index = 0
+		}
+
+		result := results[index]
+		index++
+		return result, true
+	}
+}
+
+// unorderedParallelIterator is parallelIterator for the Unordered option:
+// the results of a batch are emitted in completion order while the batch is still running.
+func unorderedParallelIterator[T, R any](
+	source func() (T, bool),
+	sc *scope,
+	batchSize int,
+	workers int,
+	fn func(T) (R, bool),
+) func() (R, bool) {
+	var current *unorderedBatch[R]
+	exhausted := false
+	sc.onClose(func() {
+		if current != nil {
+			current.cancel() // Abandoned early: stop the workers of the running batch
+		}
+	})
+
+	return func() (R, bool) {
+		for {
+			if current != nil {
+				if value, ok := current.next(); ok {
+					return value, true
+				}
+				current = nil
+			}
+			if exhausted {
+				var zero R
+				return zero, false
+			}
+
+			// Read the next batch sequentially: sources are not thread-safe
+			batch := make([]T, 0, batchSize)
+			for len(batch) < batchSize {
+				value, ok := source()
+				if !ok {
+					exhausted = true
+					break
+				}
+				batch = append(batch, value)
+			}
+			if len(batch) > 0 {
+				current = startUnorderedBatch(batch, workers, fn)
+			}
+		}
+	}
+}
+
+// parallelResult is the outcome of fn for one element of an unordered batch.
+type parallelResult[R any] struct {
+	value      R
+	keep       bool
+	panicked   bool
+	panicValue any
+}
+
+// unorderedBatch is a batch whose workers deliver results as soon as they are computed.
+type unorderedBatch[R any] struct {
+	results   chan parallelResult[R] // Buffered for the whole batch: workers never block
+	remaining int                    // Results not yet received
+	stopped   atomic.Bool
+	wg        sync.WaitGroup
+}
+
+// startUnorderedBatch starts up to workers goroutines that apply fn to the items of batch.
+func startUnorderedBatch[T, R any](batch []T, workers int, fn func(T) (R, bool)) *unorderedBatch[R] {
+	b := &unorderedBatch[R]{
+		results:   make(chan parallelResult[R], len(batch)),
+		remaining: len(batch),
+	}
+
+	var next atomic.Int64
+	for w := 0; w < min(workers, len(batch)); w++ {
+		b.wg.Add(1)
+		go func() {
+			defer b.wg.Done()
+			for !b.stopped.Load() {
+				i := int(next.Add(1) - 1)
+				if i >= len(batch) {
+					return
+				}
+				b.results <- applyRecovering(batch[i], fn)
+			}
+		}()
+	}
+	return b
+}
+
+// applyRecovering applies fn to item and reports a panic in fn as a result.
+func applyRecovering[T, R any](item T, fn func(T) (R, bool)) (result parallelResult[R]) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = parallelResult[R]{panicked: true, panicValue: r}
+		}
+	}()
+	value, keep := fn(item)
+	return parallelResult[R]{value: value, keep: keep}
+}
+
+// next waits for the next kept result in completion order.
+// A panic in fn is re-raised once the batch's workers have stopped.
+func (b *unorderedBatch[R]) next() (R, bool) {
+	for b.remaining > 0 {
+		result := <-b.results
+		b.remaining--
+		if result.panicked {
+			b.cancel()
+			panic(result.panicValue)
+		}
+		if result.keep {
+			return result.value, true
+		}
+	}
+	var zero R
+	return zero, false
+}
+
+// cancel stops the workers from starting new items and waits for the running ones.
+func (b *unorderedBatch[R]) cancel() {
+	b.stopped.Store(true)
+	b.wg.Wait()
+}
+
+// runParallelBatch applies fn to every item using cfg.workers goroutines
+// and returns the kept results in source order. A panic in fn is re-raised after all workers stop.
+//
+//nolint:gocognit
+func runParallelBatch[T, R any](batch []T, workers int, fn func(T) (R, bool)) []R {
+	if len(batch) == 0 {
+		return nil
+	}
+
+	if workers > len(batch) {
+		workers = len(batch)
+	}
+
+	var (
+		next       atomic.Int64
+		wg         sync.WaitGroup
+		panicOnce  sync.Once
+		panicValue any
+		panicked   bool
+	)
+
+	values := make([]R, len(batch))
+	kept := make([]bool, len(batch))
+
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() {
+				if r := recover(); r != nil {
+					panicOnce.Do(func() {
+						panicValue = r
+						panicked = true
+					})
+				}
+			}()
+			for {
+				i := int(next.Add(1) - 1)
+				if i >= len(batch) {
+					return
+				}
+				values[i], kept[i] = fn(batch[i])
+			}
+		}()
+	}
+	wg.Wait()
+
+	if panicked {
+		panic(panicValue)
+	}
+
+	// Compact kept values in source order
+	result := values[:0]
+	for i := range values {
+		if kept[i] {
+			result = append(result, values[i])
+		}
+	}
+	return result
+}
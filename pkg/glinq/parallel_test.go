@@ -0,0 +1,156 @@
+package glinq
+
+import (
+	"reflect"
+	"sort"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestParallelSelect(t *testing.T) {
+	t.Run("Ordered results", func(t *testing.T) {
+		result := ParallelSelect(Range(0, 2000), func(x int) int { return x * 2 }, WithWorkers(4)).ToSlice()
+
+		if len(result) != 2000 {
+			t.Fatalf("Expected 2000 elements, got %d", len(result))
+		}
+		for i, v := range result {
+			if v != i*2 {
+				t.Fatalf("Expected %d at index %d, got %d", i*2, i, v)
+			}
+		}
+	})
+
+	t.Run("Unordered results contain every element", func(t *testing.T) {
+		result := ParallelSelect(Range(0, 1000), func(x int) int { return x + 1 }, WithWorkers(8), Unordered()).ToSlice()
+
+		sort.Ints(result)
+		for i, v := range result {
+			if v != i+1 {
+				t.Fatalf("Expected %d at index %d, got %d", i+1, i, v)
+			}
+		}
+	})
+
+	t.Run("Preserves size", func(t *testing.T) {
+		s := ParallelSelect(From([]int{1, 2, 3}), func(x int) int { return x })
+		assertSize(t, s, 3, "ParallelSelect")
+	})
+
+	t.Run("Empty source", func(t *testing.T) {
+		result := ParallelSelect(Empty[int](), func(x int) int { return x }).ToSlice()
+		if len(result) != 0 {
+			t.Errorf("Expected empty result, got %v", result)
+		}
+	})
+
+	t.Run("Unknown size source", func(t *testing.T) {
+		source := Range(0, 10).Where(func(x int) bool { return x%2 == 0 })
+		result := ParallelSelect(source, func(x int) int { return x * x }, WithWorkers(2)).ToSlice()
+
+		expected := []int{0, 4, 16, 36, 64}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("Expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("Panic is re-raised on caller", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r != "boom" {
+				t.Errorf("Expected panic \"boom\", got %v", r)
+			}
+		}()
+		ParallelSelect(Range(0, 10), func(x int) int {
+			if x == 5 {
+				panic("boom")
+			}
+			return x
+		}).ToSlice()
+	})
+
+	t.Run("Unordered panic is re-raised on caller", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r != "boom" {
+				t.Errorf("Expected panic \"boom\", got %v", r)
+			}
+		}()
+		ParallelSelect(Range(0, 10), func(x int) int {
+			if x == 5 {
+				panic("boom")
+			}
+			return x
+		}, Unordered()).ToSlice()
+	})
+
+	t.Run("Unordered streams results before slow elements finish", func(t *testing.T) {
+		release := make(chan struct{})
+		var timedOut atomic.Bool
+		slow := func(x int) int {
+			if x == 0 {
+				select {
+				case <-release:
+				case <-time.After(2 * time.Second):
+					timedOut.Store(true)
+				}
+			}
+			return x
+		}
+
+		var result []int
+		for x := range ParallelSelect(Range(0, 4), slow, WithWorkers(2), Unordered()).Seq() {
+			result = append(result, x)
+			if len(result) == 3 {
+				close(release) // The slow element finishes only after the others were received
+			}
+		}
+
+		if timedOut.Load() {
+			t.Error("Expected results of fast elements before the slow element finished")
+		}
+		if !reflect.DeepEqual(result, []int{1, 2, 3, 0}) {
+			t.Errorf("Expected completion order [1 2 3 0], got %v", result)
+		}
+	})
+
+	t.Run("Unordered early exit stops workers", func(t *testing.T) {
+		var started atomic.Int64
+		first, ok := ParallelSelect(Range(0, 100), func(x int) int {
+			started.Add(1)
+			time.Sleep(time.Millisecond)
+			return x
+		}, WithWorkers(2), Unordered()).First()
+		if !ok || first > 1 {
+			t.Errorf("Expected one of the first two elements, got %d (%v)", first, ok)
+		}
+
+		n := started.Load()
+		time.Sleep(10 * time.Millisecond)
+		if started.Load() != n || n > 4 {
+			t.Errorf("Expected workers to stop after First, %d started then %d", n, started.Load())
+		}
+	})
+}
+
+func TestParallelWhere(t *testing.T) {
+	result := ParallelWhere(Range(0, 3000), func(x int) bool { return x%3 == 0 }, WithWorkers(3)).ToSlice()
+
+	if len(result) != 1000 {
+		t.Fatalf("Expected 1000 elements, got %d", len(result))
+	}
+	for i, v := range result {
+		if v != i*3 {
+			t.Fatalf("Expected %d at index %d, got %d", i*3, i, v)
+		}
+	}
+	assertNoSize(t, ParallelWhere(Range(0, 3), func(int) bool { return true }), "ParallelWhere")
+}
+
+func TestParallelForEach(t *testing.T) {
+	var sum atomic.Int64
+	ParallelForEach(Range(1, 1000), func(x int) { sum.Add(int64(x)) }, WithWorkers(4))
+
+	if sum.Load() != 500500 {
+		t.Errorf("Expected sum 500500, got %d", sum.Load())
+	}
+}
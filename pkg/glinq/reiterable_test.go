@@ -0,0 +1,158 @@
+package glinq
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+)
+
+// assertReiterable checks that a Stream yields the same elements on repeated terminal operations
+func assertReiterable[T any](t *testing.T, name string, s Stream[T], expected []T) {
+	t.Helper()
+	first := s.ToSlice()
+	count := s.Count()
+	second := s.ToSlice()
+
+	if !reflect.DeepEqual(first, expected) {
+		t.Errorf("%s: expected %v, got %v", name, expected, first)
+	}
+	if count != len(expected) {
+		t.Errorf("%s: expected second Count() %d, got %d", name, len(expected), count)
+	}
+	if !reflect.DeepEqual(second, expected) {
+		t.Errorf("%s: expected %v on re-iteration, got %v", name, expected, second)
+	}
+}
+
+// oneShot is an Enumerable that is not Restartable
+type oneShot struct {
+	items []int
+	index int
+}
+
+func (o *oneShot) Next() (int, bool) {
+	if o.index >= len(o.items) {
+		return 0, false
+	}
+	o.index++
+	return o.items[o.index-1], true
+}
+
+// restartableInts is a custom Enumerable that implements Restartable
+type restartableInts struct {
+	items []int
+	next  func() (int, bool)
+}
+
+func (r *restartableInts) Next() (int, bool) {
+	if r.next == nil {
+		r.next = r.Iterator()
+	}
+	return r.next()
+}
+
+func (r *restartableInts) Iterator() func() (int, bool) {
+	index := 0
+	return func() (int, bool) {
+		if index >= len(r.items) {
+			return 0, false
+		}
+		index++
+		return r.items[index-1], true
+	}
+}
+
+func TestReiterableFreeFunctions(t *testing.T) {
+	numbers := From([]int{1, 2, 3})
+	double := func(x int) int { return x * 2 }
+
+	assertReiterable(t, "Select", Select(numbers, double), []int{2, 4, 6})
+	assertReiterable(t, "SelectWithIndex",
+		SelectWithIndex(numbers, func(x, i int) int { return x + i }), []int{1, 3, 5})
+	assertReiterable(t, "Zip",
+		Zip(numbers, From([]int{10, 20, 30}), func(a, b int) int { return a + b }), []int{11, 22, 33})
+	assertReiterable(t, "SelectMany",
+		SelectMany(numbers, func(x int) Enumerable[int] { return Range(0, x) }), []int{0, 0, 1, 0, 1, 2})
+	assertReiterable(t, "Union", Union(numbers, From([]int{3, 4})), []int{1, 2, 3, 4})
+	assertReiterable(t, "Intersect", Intersect(numbers, From([]int{2, 3, 4})), []int{2, 3})
+	assertReiterable(t, "Except", Except(numbers, From([]int{2})), []int{1, 3})
+	assertReiterable(t, "Distinct", Distinct(From([]int{1, 1, 2, 1})), []int{1, 2})
+	assertReiterable(t, "TakeOrderedBy",
+		TakeOrderedBy(From([]int{3, 1, 2}), 2, func(a, b int) bool { return a < b }), []int{1, 2})
+	assertReiterable(t, "FromEnumerable", FromEnumerable[int](numbers), []int{1, 2, 3})
+	assertReiterable(t, "Concat", numbers.Concat(Select(numbers, double)), []int{1, 2, 3, 2, 4, 6})
+	assertReiterable(t, "ParallelSelect", ParallelSelect(numbers, double), []int{2, 4, 6})
+	assertReiterable(t, "ParallelWhere",
+		ParallelWhere(numbers, func(x int) bool { return x != 2 }), []int{1, 3})
+	assertReiterable(t, "Join",
+		Join(numbers, From([]int{2, 3}), double, double, func(a, b int) int { return a * b }), []int{4, 9})
+	assertReiterable(t, "OrderByKey",
+		OrderByKey(Select(numbers, func(x int) int { return -x }), func(x int) int { return x }), []int{-3, -2, -1})
+
+	pairs := FromMapSafe(map[string]int{"a": 1})
+	assertReiterable(t, "Keys", Keys(pairs), []string{"a"})
+	assertReiterable(t, "Values", Values(pairs), []int{1})
+	assertReiterable(t, "GroupBy",
+		Keys(GroupBy(From([]int{1, 2, 3, 4}), func(x int) int { return x % 2 })), []int{1, 0})
+}
+
+func TestReiterableTerminalFunctions(t *testing.T) {
+	numbers := Select(From([]int{3, 1, 2}), func(x int) int { return x })
+
+	for i := 0; i < 2; i++ {
+		if sum := Sum(numbers); sum != 6 {
+			t.Errorf("Sum pass %d: expected 6, got %d", i, sum)
+		}
+		if minVal, ok := Min(numbers); !ok || minVal != 1 {
+			t.Errorf("Min pass %d: expected 1, got %d", i, minVal)
+		}
+		if maxVal, ok := Max(numbers); !ok || maxVal != 3 {
+			t.Errorf("Max pass %d: expected 3, got %d", i, maxVal)
+		}
+		if m := ToMapBy(numbers, func(x int) int { return x }, func(x int) int { return x }); len(m) != 3 {
+			t.Errorf("ToMapBy pass %d: expected 3 entries, got %d", i, len(m))
+		}
+		if result := slices.Collect(ToSeq[int](numbers)); len(result) != 3 {
+			t.Errorf("ToSeq pass %d: expected 3 elements, got %v", i, result)
+		}
+	}
+}
+
+func TestNonRestartableEnumerable(t *testing.T) {
+	s := FromEnumerable[int](&oneShot{items: []int{1, 2, 3}})
+
+	if count := s.Count(); count != 3 {
+		t.Errorf("Expected 3 elements on first pass, got %d", count)
+	}
+	if count := s.Count(); count != 0 {
+		t.Errorf("Expected exhausted one-shot source on second pass, got %d", count)
+	}
+}
+
+func TestOneShotPropagation(t *testing.T) {
+	ch := make(chan int)
+	close(ch)
+	double := func(x int) int { return x * 2 }
+	odd := func(x int) bool { return x%2 == 1 }
+
+	cases := []struct {
+		name    string
+		enum    Enumerable[int]
+		oneShot bool
+	}{
+		{"From", From([]int{1, 2}).Where(odd).Select(double), false},
+		{"Restartable custom", FromEnumerable[int](&restartableInts{items: []int{1}}), false},
+		{"FromChannel", Select(FromChannel(ch).Where(odd), double), true},
+		{"FromEnumerable one-shot", FromEnumerable[int](&oneShot{items: []int{1}}).OrderBy(func(a, b int) int { return a - b }), true},
+		{"Concat of one-shot", From([]int{1}).Concat(FromSeq(slices.Values([]int{2}))), true},
+		{"Zip with one-shot", Zip(From([]int{1}), &oneShot{items: []int{1}}, func(a, b int) int { return a + b }), true},
+	}
+	for _, c := range cases {
+		if got := isOneShot(c.enum); got != c.oneShot {
+			t.Errorf("%s: expected oneShot=%v, got %v", c.name, c.oneShot, got)
+		}
+		if _, ok := c.enum.(Restartable[int]); ok {
+			t.Errorf("%s: Streams must not claim to be Restartable", c.name)
+		}
+	}
+}
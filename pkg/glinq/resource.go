@@ -0,0 +1,66 @@
+package glinq
+
+// FromResource creates a Stream over a resource that must be released after use,
+// such as a file, a database cursor or a network stream.
+// open is called lazily on the first element of every iteration and returns the iterator
+// over the resource together with the function that releases it.
+//
+// release is called exactly once per iteration: when the iterator is exhausted, when a
+// terminal operation stops early (First, Take, AnyMatch, a break out of Seq, ...) or panics,
+// and when Close is called on a Stream consumed through Next. Every operator (Where, Select,
+// Concat, SelectMany, Zip, Union, ...) passes this guarantee on to the resources it reads.
+//
+// SIZE: Unknown (the number of elements in the resource is not known in advance).
+//
+// Example:
+//
+//	lines := FromResource(func() (func() (string, bool), func()) {
+//	    f, err := os.Open("access.log")
+//	    if err != nil {
+//	        return func() (string, bool) { return "", false }, nil
+//	    }
+//	    scanner := bufio.NewScanner(f)
+//	    next := func() (string, bool) {
+//	        if !scanner.Scan() {
+//	            return "", false
+//	        }
+//	        return scanner.Text(), true
+//	    }
+//	    return next, func() { _ = f.Close() }
+//	})
+//	firstError, ok := lines.FirstWhere(isError) // The file is closed as soon as a match is found
+func FromResource[T any](open func() (next func() (T, bool), release func())) Stream[T] {
+	return &stream[T]{
+		sourceFactory: func(sc *scope) func() (T, bool) {
+			var next func() (T, bool)
+			var release func()
+			done := false
+
+			dispose := func() {
+				if release != nil {
+					r := release
+					release = nil // Release at most once
+					r()
+				}
+			}
+			sc.onClose(dispose)
+
+			return func() (T, bool) {
+				var zero T
+				if done {
+					return zero, false
+				}
+				if next == nil {
+					next, release = open() // Open the resource on first pull
+				}
+				value, ok := next()
+				if !ok {
+					done = true
+					dispose() // Release as soon as the resource is exhausted
+				}
+				return value, ok
+			}
+		},
+		size: -1, // UNKNOWN: resource length is not known in advance
+	}
+}
@@ -0,0 +1,316 @@
+package glinq
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+// handles counts the resources opened by countingResource and how often they were released.
+type handles struct {
+	opened   int
+	released int
+}
+
+// open returns the number of handles that are still open.
+func (h *handles) open() int {
+	return h.opened - h.released
+}
+
+// countingResource returns a FromResource Stream over values that records every open and release in h.
+func countingResource(h *handles, values ...int) Stream[int] {
+	return FromResource(func() (func() (int, bool), func()) {
+		h.opened++
+		index := 0
+		next := func() (int, bool) {
+			if index >= len(values) {
+				return 0, false
+			}
+			index++
+			return values[index-1], true
+		}
+		return next, func() { h.released++ }
+	})
+}
+
+// closingEnumerable is a one-shot Enumerable that implements io.Closer.
+type closingEnumerable struct {
+	values []int
+	closed int
+}
+
+func (c *closingEnumerable) Next() (int, bool) {
+	if len(c.values) == 0 {
+		return 0, false
+	}
+	value := c.values[0]
+	c.values = c.values[1:]
+	return value, true
+}
+
+func (c *closingEnumerable) Close() error {
+	c.closed++
+	return nil
+}
+
+func assertReleased(t *testing.T, h *handles, opened int) {
+	t.Helper()
+	if h.opened != opened {
+		t.Errorf("Expected %d opened handles, got %d", opened, h.opened)
+	}
+	if h.released != h.opened {
+		t.Errorf("Expected every handle to be released exactly once, opened %d, released %d", h.opened, h.released)
+	}
+}
+
+func TestFromResource(t *testing.T) {
+	t.Run("Exhaustion releases once", func(t *testing.T) {
+		h := &handles{}
+		result := countingResource(h, 1, 2, 3).ToSlice()
+
+		expected := []int{1, 2, 3}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("Expected %v, got %v", expected, result)
+		}
+		assertReleased(t, h, 1)
+	})
+
+	t.Run("Opens lazily", func(t *testing.T) {
+		h := &handles{}
+		s := countingResource(h, 1, 2, 3).Where(func(x int) bool { return x > 1 })
+		if h.opened != 0 {
+			t.Errorf("Expected no handle before iteration, got %d", h.opened)
+		}
+
+		Zip(s, Empty[int](), func(a, b int) int { return a + b }).ToSlice()
+		if h.opened != 0 {
+			t.Errorf("Expected no handle for an empty zip, got %d", h.opened)
+		}
+	})
+
+	t.Run("Every iteration opens a fresh handle", func(t *testing.T) {
+		h := &handles{}
+		s := countingResource(h, 1, 2, 3)
+		s.Count()
+		s.ToSlice()
+		assertReleased(t, h, 2)
+	})
+}
+
+func TestResourceEarlyExit(t *testing.T) {
+	tests := []struct {
+		name string
+		run  func(s Stream[int])
+	}{
+		{"First", func(s Stream[int]) { s.First() }},
+		{"FirstWhere", func(s Stream[int]) { s.FirstWhere(func(x int) bool { return x == 2 }) }},
+		{"Take", func(s Stream[int]) { s.Take(2).ToSlice() }},
+		{"TakeWhile", func(s Stream[int]) { s.TakeWhile(func(x int) bool { return x < 2 }).ToSlice() }},
+		{"AnyMatch", func(s Stream[int]) { s.AnyMatch(func(x int) bool { return x == 2 }) }},
+		{"All", func(s Stream[int]) { s.All(func(x int) bool { return x < 2 }) }},
+		{"ElementAt", func(s Stream[int]) { s.ElementAt(1) }},
+		{"Contains", func(s Stream[int]) { s.Contains(2) }},
+		{"Seq break", func(s Stream[int]) {
+			for x := range s.Seq() {
+				if x == 2 {
+					break
+				}
+			}
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &handles{}
+			tt.run(countingResource(h, 1, 2, 3, 4, 5))
+			assertReleased(t, h, 1)
+		})
+	}
+}
+
+func TestResourcePropagation(t *testing.T) {
+	isEven := func(x int) bool { return x%2 == 0 }
+	double := func(x int) int { return x * 2 }
+
+	tests := []struct {
+		name    string
+		opened  int
+		compose func(h *handles) Stream[int]
+	}{
+		{"Where", 1, func(h *handles) Stream[int] {
+			return countingResource(h, 1, 2, 3, 4).Where(isEven)
+		}},
+		{"Select", 1, func(h *handles) Stream[int] {
+			return Select(countingResource(h, 1, 2, 3, 4), double)
+		}},
+		{"Concat", 2, func(h *handles) Stream[int] {
+			return countingResource(h, 1, 2).Concat(countingResource(h, 3, 4))
+		}},
+		{"SelectMany", 3, func(h *handles) Stream[int] {
+			return SelectMany(countingResource(h, 1, 2), func(x int) Enumerable[int] {
+				return countingResource(h, x, x*10)
+			})
+		}},
+		{"Zip", 2, func(h *handles) Stream[int] {
+			return Zip(countingResource(h, 1, 2, 3), countingResource(h, 4, 5, 6), func(a, b int) int { return a + b })
+		}},
+		{"Union", 2, func(h *handles) Stream[int] {
+			return Union(countingResource(h, 1, 2, 3), countingResource(h, 3, 4, 5))
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name+" exhausted", func(t *testing.T) {
+			h := &handles{}
+			tt.compose(h).ToSlice()
+			assertReleased(t, h, tt.opened)
+		})
+
+		t.Run(tt.name+" early exit", func(t *testing.T) {
+			h := &handles{}
+			if _, ok := tt.compose(h).First(); !ok {
+				t.Fatal("Expected an element")
+			}
+			if h.open() != 0 {
+				t.Errorf("Expected no open handles after First, got %d", h.open())
+			}
+		})
+	}
+}
+
+func TestSelectManyReleasesEachInnerStream(t *testing.T) {
+	t.Run("Released before the next one opens", func(t *testing.T) {
+		var inners []*closingEnumerable
+		result := SelectMany(From([]int{1, 2, 3}), func(x int) Enumerable[int] {
+			for _, previous := range inners {
+				if previous.closed != 1 {
+					t.Errorf("Expected the inner stream before %d to be closed, got %d closes", x, previous.closed)
+				}
+			}
+			inner := &closingEnumerable{values: []int{x, x * 10}}
+			inners = append(inners, inner)
+			return inner
+		}).ToSlice()
+
+		if expected := []int{1, 10, 2, 20, 3, 30}; !reflect.DeepEqual(result, expected) {
+			t.Errorf("Expected %v, got %v", expected, result)
+		}
+		for i, inner := range inners {
+			if inner.closed != 1 {
+				t.Errorf("Expected inner stream %d to be closed once, got %d", i, inner.closed)
+			}
+		}
+	})
+
+	t.Run("Scope stays bounded", func(t *testing.T) {
+		s := SelectMany(Range(0, 1000), func(i int) Enumerable[int] {
+			return FromSeq(func(yield func(int) bool) { yield(i) })
+		}).(*stream[int])
+
+		sc := &scope{}
+		next := s.sourceFactory(sc)
+		count := 0
+		for _, ok := next(); ok; _, ok = next() {
+			count++
+			if len(sc.releases) > 1 {
+				t.Fatalf("Expected at most 1 release in the outer scope, got %d after %d elements", len(sc.releases), count)
+			}
+		}
+		sc.close()
+		if count != 1000 {
+			t.Errorf("Expected 1000 elements, got %d", count)
+		}
+	})
+}
+
+func TestResourcePanic(t *testing.T) {
+	h := &handles{}
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Error("Expected panic")
+			}
+		}()
+		countingResource(h, 1, 2, 3).Where(func(x int) bool { return x > 0 }).ForEach(func(x int) {
+			if x == 2 {
+				panic("boom")
+			}
+		})
+	}()
+	assertReleased(t, h, 1)
+}
+
+func TestResourceNextAndClose(t *testing.T) {
+	t.Run("Close releases an abandoned Next iteration", func(t *testing.T) {
+		h := &handles{}
+		s := countingResource(h, 1, 2, 3).Where(func(x int) bool { return x > 0 })
+		if x, ok := s.Next(); !ok || x != 1 {
+			t.Errorf("Expected 1, got %d (%v)", x, ok)
+		}
+		if h.open() != 1 {
+			t.Errorf("Expected one open handle, got %d", h.open())
+		}
+
+		if err := s.Close(); err != nil {
+			t.Errorf("Unexpected error: %v", err)
+		}
+		if err := s.Close(); err != nil {
+			t.Errorf("Unexpected error: %v", err)
+		}
+		assertReleased(t, h, 1)
+
+		if _, ok := s.Next(); ok {
+			t.Error("Expected Next to report no elements after Close")
+		}
+	})
+
+	t.Run("Exhausting Next releases", func(t *testing.T) {
+		h := &handles{}
+		s := countingResource(h, 1, 2)
+		for _, ok := s.Next(); ok; _, ok = s.Next() {
+		}
+		assertReleased(t, h, 1)
+	})
+
+	t.Run("Close without Next", func(t *testing.T) {
+		if err := From([]int{1}).Close(); err != nil {
+			t.Errorf("Unexpected error: %v", err)
+		}
+	})
+}
+
+func TestResourceToChannelCancel(t *testing.T) {
+	h := &handles{}
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := countingResource(h, 1, 2, 3, 4, 5).ToChannel(ctx, 0)
+
+	if x := <-ch; x != 1 {
+		t.Errorf("Expected 1, got %d", x)
+	}
+	cancel()
+	for range ch { // Wait for the producer to stop
+	}
+	assertReleased(t, h, 1)
+}
+
+func TestEnumerableCloser(t *testing.T) {
+	t.Run("Closed on early exit", func(t *testing.T) {
+		source := &closingEnumerable{values: []int{1, 2, 3}}
+		if x, ok := FromEnumerable[int](source).Where(func(x int) bool { return x > 1 }).First(); !ok || x != 2 {
+			t.Errorf("Expected 2, got %d (%v)", x, ok)
+		}
+		if source.closed != 1 {
+			t.Errorf("Expected Close to be called once, got %d", source.closed)
+		}
+	})
+
+	t.Run("Closed on exhaustion", func(t *testing.T) {
+		source := &closingEnumerable{values: []int{1, 2, 3}}
+		if n := FromEnumerable[int](source).Count(); n != 3 {
+			t.Errorf("Expected 3, got %d", n)
+		}
+		if source.closed != 1 {
+			t.Errorf("Expected Close to be called once, got %d", source.closed)
+		}
+	})
+}
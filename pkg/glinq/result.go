@@ -0,0 +1,412 @@
+package glinq
+
+import "context"
+
+// ResultStream is a cancellable sequence whose source and operators may fail.
+// It mirrors the Stream operators, but every callback can return an error and
+// every terminal operation reports the first error encountered.
+//
+// Iteration stops as soon as the source's context is cancelled or any callback fails;
+// terminal operations then return ctx.Err() or the callback error respectively.
+//
+// Example:
+//
+//	rows, err := FromFallible(ctx, openRows).
+//	    Select(parseRow).
+//	    Where(isValid).
+//	    ToSlice()
+type ResultStream[T any] interface {
+	// Where filters elements by a fallible predicate.
+	Where(predicate func(T) (bool, error)) ResultStream[T]
+	// Select transforms elements to the same type with a fallible mapper.
+	Select(mapper func(T) (T, error)) ResultStream[T]
+	// Take takes the first n elements.
+	Take(n int) ResultStream[T]
+	// Skip skips the first n elements.
+	Skip(n int) ResultStream[T]
+	// ToSlice materializes the elements into a slice, or returns the first error.
+	ToSlice() ([]T, error)
+	// First returns the first element and true, zero value and false if there are no elements,
+	// or the first error encountered.
+	First() (T, bool, error)
+	// Count returns the number of elements, or the first error encountered.
+	Count() (int, error)
+	// ForEach executes an action for each element and stops at the first error.
+	ForEach(action func(T) error) error
+	// Aggregate applies a fallible accumulator function over the elements.
+	Aggregate(seed T, accumulator func(T, T) (T, error)) (T, error)
+}
+
+// resultStream represents the internal implementation of ResultStream.
+// An iterator returns (value, true, nil) for an element, (zero, false, nil) at the end,
+// and (zero, false, err) on failure.
+type resultStream[T any] struct {
+	sourceFactory func(sc *scope) func() (T, bool, error)
+}
+
+// WithContext lifts an Enumerable into a ResultStream bound to ctx.
+// Iteration stops with ctx.Err() once ctx is cancelled.
+// If enum is a Stream or Restartable, each terminal operation starts a fresh iteration.
+//
+// Example:
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+//	defer cancel()
+//	n, err := WithContext(ctx, Range(0, 1_000_000)).
+//	    Where(func(x int) (bool, error) { return x%7 == 0, nil }).
+//	    Count()
+func WithContext[T any](ctx context.Context, enum Enumerable[T]) ResultStream[T] {
+	return newFallible(ctx, func(sc *scope) func() (T, bool, error) {
+		next := iteratorOf(enum, sc) // Fresh source if possible
+		return func() (T, bool, error) {
+			value, ok := next()
+			return value, ok, nil
+		}
+	})
+}
+
+// FromFallible creates a ResultStream from a fallible source bound to ctx.
+// open is called once per terminal operation and must return a fresh iterator that yields
+// (value, true, nil) for each element, (zero, false, nil) at the end and (zero, false, err) on failure.
+// ctx is checked before every pull; once an error is observed the iterator keeps reporting it.
+//
+// Example:
+//
+//	lines := FromFallible(ctx, func() func() (string, bool, error) {
+//	    scanner := bufio.NewScanner(r)
+//	    return func() (string, bool, error) {
+//	        if scanner.Scan() {
+//	            return scanner.Text(), true, nil
+//	        }
+//	        return "", false, scanner.Err()
+//	    }
+//	})
+func FromFallible[T any](ctx context.Context, open func() func() (T, bool, error)) ResultStream[T] {
+	return newFallible(ctx, func(*scope) func() (T, bool, error) {
+		return open()
+	})
+}
+
+// FromFallibleResource creates a ResultStream over a resource that must be released after use,
+// such as a file or a database cursor, bound to ctx.
+// open is called lazily on the first pull of every terminal operation and returns the iterator
+// (see FromFallible), the function that releases the resource, and an error if the resource
+// could not be opened; that error is reported by the terminal operation.
+//
+// release is called exactly once per iteration: when the iterator ends or fails, and when the
+// terminal operation stops early (First, Take, ...) or panics.
+//
+// Example:
+//
+//	rows := FromFallibleResource(ctx, func() (func() (string, bool, error), func(), error) {
+//	    f, err := os.Open("rows.csv")
+//	    if err != nil {
+//	        return nil, nil, err
+//	    }
+//	    scanner := bufio.NewScanner(f)
+//	    next := func() (string, bool, error) {
+//	        if scanner.Scan() {
+//	            return scanner.Text(), true, nil
+//	        }
+//	        return "", false, scanner.Err()
+//	    }
+//	    return next, func() { _ = f.Close() }, nil
+//	})
+//	header, ok, err := rows.First() // The file is closed here
+func FromFallibleResource[T any](
+	ctx context.Context,
+	open func() (next func() (T, bool, error), release func(), err error),
+) ResultStream[T] {
+	return newFallible(ctx, func(sc *scope) func() (T, bool, error) {
+		next, release, err := open()
+		if err != nil {
+			if release != nil {
+				release()
+			}
+			return func() (T, bool, error) {
+				var zero T
+				return zero, false, err
+			}
+		}
+
+		dispose := func() {
+			if release != nil {
+				r := release
+				release = nil // Release at most once
+				r()
+			}
+		}
+		sc.onClose(dispose)
+
+		return func() (T, bool, error) {
+			value, ok, err := next()
+			if err != nil || !ok {
+				dispose() // Release as soon as the resource ends or fails
+			}
+			return value, ok, err
+		}
+	})
+}
+
+// newFallible implements FromFallible for sources that register resources in the iteration scope.
+func newFallible[T any](ctx context.Context, open func(sc *scope) func() (T, bool, error)) ResultStream[T] {
+	return &resultStream[T]{
+		sourceFactory: func(sc *scope) func() (T, bool, error) {
+			var source func() (T, bool, error) // Opened lazily on first pull
+			var failed error
+			return func() (T, bool, error) {
+				var zero T
+				if failed != nil {
+					return zero, false, failed
+				}
+				if err := ctx.Err(); err != nil {
+					failed = err
+					return zero, false, err
+				}
+				if source == nil {
+					source = open(sc)
+				}
+				value, ok, err := source()
+				if err != nil {
+					failed = err
+					return zero, false, err
+				}
+				return value, ok, nil
+			}
+		},
+	}
+}
+
+// TrySelect transforms elements to a different type with a fallible mapper.
+// This is a function (not a method) because in Go methods cannot have their own type parameters.
+//
+// Example:
+//
+//	numbers, err := TrySelect(
+//	    WithContext(ctx, From([]string{"1", "2", "x"})),
+//	    strconv.Atoi,
+//	).ToSlice()
+//	// numbers = nil, err = strconv.Atoi: parsing "x": invalid syntax
+//
+// NOTE: A ResultStream implemented outside this package is materialized with ToSlice
+// on the first pull, since the interface has no way to pull single elements.
+func TrySelect[T, R any](rs ResultStream[T], mapper func(T) (R, error)) ResultStream[R] {
+	return &resultStream[R]{
+		sourceFactory: func(sc *scope) func() (R, bool, error) {
+			next := fallibleIteratorOf(rs, sc) // Get fresh source
+			return func() (R, bool, error) {
+				var zero R
+				value, ok, err := next()
+				if err != nil || !ok {
+					return zero, false, err
+				}
+				result, err := mapper(value)
+				if err != nil {
+					return zero, false, err
+				}
+				return result, true, nil
+			}
+		},
+	}
+}
+
+// fallibleIteratorOf returns a fresh iterator over rs whose resources are registered in sc.
+// ResultStreams of this package are pulled element by element; other implementations
+// are materialized with ToSlice on the first pull.
+func fallibleIteratorOf[T any](rs ResultStream[T], sc *scope) func() (T, bool, error) {
+	if scoped, ok := rs.(interface {
+		iterate(sc *scope) func() (T, bool, error)
+	}); ok {
+		return scoped.iterate(sc)
+	}
+
+	var items []T
+	var failed error
+	loaded := false
+	index := 0
+	return func() (T, bool, error) {
+		var zero T
+		if !loaded {
+			items, failed = rs.ToSlice()
+			loaded = true
+		}
+		if failed != nil {
+			return zero, false, failed
+		}
+		if index >= len(items) {
+			return zero, false, nil
+		}
+		index++
+		return items[index-1], true, nil
+	}
+}
+
+// iterate starts a fresh iteration whose resources are registered in sc.
+func (r *resultStream[T]) iterate(sc *scope) func() (T, bool, error) {
+	return r.sourceFactory(sc)
+}
+
+// open starts a fresh iteration for a terminal operation.
+// The caller must close the returned scope when done (see stream.open).
+func (r *resultStream[T]) open() (func() (T, bool, error), *scope) {
+	sc := &scope{}
+	return r.sourceFactory(sc), sc
+}
+
+// Where filters elements by a fallible predicate.
+func (r *resultStream[T]) Where(predicate func(T) (bool, error)) ResultStream[T] {
+	return &resultStream[T]{
+		sourceFactory: func(sc *scope) func() (T, bool, error) {
+			source := r.sourceFactory(sc) // Get fresh source
+			return func() (T, bool, error) {
+				var zero T
+				for {
+					value, ok, err := source()
+					if err != nil || !ok {
+						return zero, false, err
+					}
+					keep, err := predicate(value)
+					if err != nil {
+						return zero, false, err
+					}
+					if keep {
+						return value, true, nil
+					}
+				}
+			}
+		},
+	}
+}
+
+// Select transforms elements to the same type with a fallible mapper.
+func (r *resultStream[T]) Select(mapper func(T) (T, error)) ResultStream[T] {
+	return TrySelect[T, T](r, mapper)
+}
+
+// Take takes the first n elements.
+// If n is negative, no elements are returned.
+func (r *resultStream[T]) Take(n int) ResultStream[T] {
+	return &resultStream[T]{
+		sourceFactory: func(sc *scope) func() (T, bool, error) {
+			source := r.sourceFactory(sc) // Get fresh source
+			count := 0                    // Fresh counter
+			return func() (T, bool, error) {
+				if count >= n {
+					var zero T
+					return zero, false, nil
+				}
+				value, ok, err := source()
+				if ok {
+					count++
+				}
+				return value, ok, err
+			}
+		},
+	}
+}
+
+// Skip skips the first n elements.
+// If n is negative, treats it as 0 (no skipping).
+func (r *resultStream[T]) Skip(n int) ResultStream[T] {
+	return &resultStream[T]{
+		sourceFactory: func(sc *scope) func() (T, bool, error) {
+			source := r.sourceFactory(sc) // Get fresh source
+			skipped := 0                  // Fresh counter
+			return func() (T, bool, error) {
+				for skipped < n {
+					_, ok, err := source()
+					if err != nil || !ok {
+						var zero T
+						return zero, false, err
+					}
+					skipped++
+				}
+				return source()
+			}
+		},
+	}
+}
+
+// ToSlice materializes the elements into a slice, or returns nil and the first error.
+func (r *resultStream[T]) ToSlice() ([]T, error) {
+	iterator, sc := r.open() // Fresh iterator
+	defer sc.close()
+	var result []T
+	for {
+		value, ok, err := iterator()
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return result, nil
+		}
+		result = append(result, value)
+	}
+}
+
+// First returns the first element and true, zero value and false if there are no elements,
+// or the error that prevented reading it.
+func (r *resultStream[T]) First() (T, bool, error) {
+	iterator, sc := r.open() // Fresh iterator
+	defer sc.close()
+	return iterator()
+}
+
+// Count returns the number of elements, or 0 and the first error.
+func (r *resultStream[T]) Count() (int, error) {
+	iterator, sc := r.open() // Fresh iterator
+	defer sc.close()
+	count := 0
+	for {
+		_, ok, err := iterator()
+		if err != nil {
+			return 0, err
+		}
+		if !ok {
+			return count, nil
+		}
+		count++
+	}
+}
+
+// ForEach executes an action for each element and stops at the first error,
+// whether it comes from the source, an operator or the action itself.
+func (r *resultStream[T]) ForEach(action func(T) error) error {
+	iterator, sc := r.open() // Fresh iterator
+	defer sc.close()
+	for {
+		value, ok, err := iterator()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+		if err := action(value); err != nil {
+			return err
+		}
+	}
+}
+
+// Aggregate applies a fallible accumulator function over the elements.
+// Returns the final accumulator value, or zero value and the first error.
+func (r *resultStream[T]) Aggregate(seed T, accumulator func(T, T) (T, error)) (T, error) {
+	iterator, sc := r.open() // Fresh iterator
+	defer sc.close()
+	result := seed
+	for {
+		value, ok, err := iterator()
+		if err != nil {
+			var zero T
+			return zero, err
+		}
+		if !ok {
+			return result, nil
+		}
+		result, err = accumulator(result, value)
+		if err != nil {
+			var zero T
+			return zero, err
+		}
+	}
+}
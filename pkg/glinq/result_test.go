@@ -0,0 +1,245 @@
+package glinq
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestWithContext(t *testing.T) {
+	t.Run("Pipeline without errors", func(t *testing.T) {
+		result, err := WithContext(context.Background(), From([]int{1, 2, 3, 4, 5})).
+			Where(func(x int) (bool, error) { return x%2 == 1, nil }).
+			Select(func(x int) (int, error) { return x * 10, nil }).
+			ToSlice()
+
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		expected := []int{10, 30, 50}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("Expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("Cancelled context stops iteration", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		visited := 0
+		err := WithContext(ctx, Range(0, 100)).ForEach(func(x int) error {
+			visited++
+			if x == 4 {
+				cancel()
+			}
+			return nil
+		})
+
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("Expected context.Canceled, got %v", err)
+		}
+		if visited != 5 {
+			t.Errorf("Expected 5 elements visited, got %d", visited)
+		}
+	})
+
+	t.Run("Already cancelled context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		count, err := WithContext(ctx, Range(0, 10)).Count()
+		if !errors.Is(err, context.Canceled) || count != 0 {
+			t.Errorf("Expected 0 and context.Canceled, got %d and %v", count, err)
+		}
+	})
+
+	t.Run("Re-iterable over Stream", func(t *testing.T) {
+		rs := WithContext(context.Background(), From([]int{1, 2, 3}))
+		first, _ := rs.Count()
+		second, _ := rs.Count()
+		if first != 3 || second != 3 {
+			t.Errorf("Expected 3 and 3, got %d and %d", first, second)
+		}
+	})
+}
+
+func TestFromFallible(t *testing.T) {
+	errBroken := errors.New("broken source")
+	open := func() func() (int, bool, error) {
+		i := 0
+		return func() (int, bool, error) {
+			i++
+			if i > 3 {
+				return 0, false, errBroken
+			}
+			return i, true, nil
+		}
+	}
+
+	t.Run("Source error surfaces from ToSlice", func(t *testing.T) {
+		result, err := FromFallible(context.Background(), open).ToSlice()
+		if !errors.Is(err, errBroken) {
+			t.Errorf("Expected errBroken, got %v", err)
+		}
+		if result != nil {
+			t.Errorf("Expected nil result, got %v", result)
+		}
+	})
+
+	t.Run("Take stops before source error", func(t *testing.T) {
+		result, err := FromFallible(context.Background(), open).Take(2).ToSlice()
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		expected := []int{1, 2}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("Expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("Skip", func(t *testing.T) {
+		first, ok, err := FromFallible(context.Background(), open).Skip(2).First()
+		if err != nil || !ok || first != 3 {
+			t.Errorf("Expected 3, true, nil, got %d, %v, %v", first, ok, err)
+		}
+	})
+
+	t.Run("Aggregate reports error", func(t *testing.T) {
+		_, err := FromFallible(context.Background(), open).
+			Aggregate(0, func(acc, x int) (int, error) { return acc + x, nil })
+		if !errors.Is(err, errBroken) {
+			t.Errorf("Expected errBroken, got %v", err)
+		}
+	})
+}
+
+func TestTrySelect(t *testing.T) {
+	t.Run("Mapper error stops pipeline", func(t *testing.T) {
+		parsed := 0
+		_, err := TrySelect(
+			WithContext(context.Background(), From([]string{"1", "x", "3"})),
+			func(s string) (int, error) {
+				parsed++
+				return strconv.Atoi(s)
+			},
+		).ToSlice()
+
+		var numErr *strconv.NumError
+		if !errors.As(err, &numErr) {
+			t.Errorf("Expected *strconv.NumError, got %v", err)
+		}
+		if parsed != 2 {
+			t.Errorf("Expected mapper to stop after 2 calls, got %d", parsed)
+		}
+	})
+
+	t.Run("Type-changing aggregate", func(t *testing.T) {
+		sum, err := TrySelect(
+			WithContext(context.Background(), From([]string{"1", "2", "3"})),
+			strconv.Atoi,
+		).Aggregate(0, func(acc, x int) (int, error) { return acc + x, nil })
+
+		if err != nil || sum != 6 {
+			t.Errorf("Expected 6, nil, got %d, %v", sum, err)
+		}
+	})
+
+	t.Run("Predicate error", func(t *testing.T) {
+		errBad := errors.New("bad")
+		count, err := WithContext(context.Background(), Range(0, 5)).
+			Where(func(x int) (bool, error) {
+				if x == 3 {
+					return false, errBad
+				}
+				return true, nil
+			}).
+			Count()
+		if !errors.Is(err, errBad) || count != 0 {
+			t.Errorf("Expected 0 and errBad, got %d and %v", count, err)
+		}
+	})
+}
+
+// sliceResults is a ResultStream implemented outside the package internals (a test double).
+// Only ToSlice is implemented; the embedded interface is nil.
+type sliceResults struct {
+	ResultStream[string]
+	items []string
+	err   error
+}
+
+func (s sliceResults) ToSlice() ([]string, error) {
+	return s.items, s.err
+}
+
+func TestTrySelectForeignResultStream(t *testing.T) {
+	numbers, err := TrySelect(ResultStream[string](sliceResults{items: []string{"1", "2"}}), strconv.Atoi).ToSlice()
+	if err != nil || !reflect.DeepEqual(numbers, []int{1, 2}) {
+		t.Errorf("Expected [1 2], nil, got %v, %v", numbers, err)
+	}
+
+	errBroken := errors.New("broken")
+	_, err = TrySelect(ResultStream[string](sliceResults{err: errBroken}), strconv.Atoi).ToSlice()
+	if !errors.Is(err, errBroken) {
+		t.Errorf("Expected errBroken, got %v", err)
+	}
+}
+
+func TestFromFallibleResource(t *testing.T) {
+	errBroken := errors.New("broken")
+	resource := func(h *handles, values []int, failAt int) ResultStream[int] {
+		return FromFallibleResource(context.Background(), func() (func() (int, bool, error), func(), error) {
+			h.opened++
+			index := 0
+			next := func() (int, bool, error) {
+				if index == failAt {
+					return 0, false, errBroken
+				}
+				if index >= len(values) {
+					return 0, false, nil
+				}
+				index++
+				return values[index-1], true, nil
+			}
+			return next, func() { h.released++ }, nil
+		})
+	}
+
+	t.Run("Exhaustion releases once", func(t *testing.T) {
+		h := &handles{}
+		result, err := resource(h, []int{1, 2, 3}, -1).ToSlice()
+		if err != nil || !reflect.DeepEqual(result, []int{1, 2, 3}) {
+			t.Errorf("Expected [1 2 3], nil, got %v, %v", result, err)
+		}
+		assertReleased(t, h, 1)
+	})
+
+	t.Run("Early exit releases", func(t *testing.T) {
+		h := &handles{}
+		if first, ok, err := resource(h, []int{1, 2, 3}, -1).First(); err != nil || !ok || first != 1 {
+			t.Errorf("Expected 1, true, nil, got %d, %v, %v", first, ok, err)
+		}
+		if _, err := TrySelect(resource(h, []int{1, 2, 3}, -1).Take(1), func(x int) (string, error) {
+			return strconv.Itoa(x), nil
+		}).ToSlice(); err != nil {
+			t.Errorf("Unexpected error: %v", err)
+		}
+		assertReleased(t, h, 2)
+	})
+
+	t.Run("Error releases", func(t *testing.T) {
+		h := &handles{}
+		if _, err := resource(h, []int{1, 2, 3}, 1).Count(); !errors.Is(err, errBroken) {
+			t.Errorf("Expected errBroken, got %v", err)
+		}
+		assertReleased(t, h, 1)
+	})
+
+	t.Run("Open error", func(t *testing.T) {
+		rs := FromFallibleResource(context.Background(), func() (func() (int, bool, error), func(), error) {
+			return nil, nil, errBroken
+		})
+		if _, err := rs.ToSlice(); !errors.Is(err, errBroken) {
+			t.Errorf("Expected errBroken, got %v", err)
+		}
+	})
+}
@@ -7,18 +7,17 @@ package glinq
 func (s *stream[T]) Concat(other Enumerable[T]) Stream[T] {
 	var newSize = -1
 	if s.size != -1 {
-		if sizable, ok := other.(Sizable[T]); ok {
-			if otherSize, known := sizable.Size(); known {
-				newSize = s.size + otherSize
-			}
+		if otherSize := sizeOf(other); otherSize != -1 {
+			newSize = s.size + otherSize
 		}
 	}
 	// else: -1 (unknown)
 
 	return &stream[T]{
-		sourceFactory: func() func() (T, bool) {
-			source := s.sourceFactory() // Get fresh source
-			firstExhausted := false     // Fresh flag for each iterator
+		sourceFactory: func(sc *scope) func() (T, bool) {
+			source := s.sourceFactory(sc) // Get fresh source
+			var otherSource func() (T, bool)
+			firstExhausted := false // Fresh flag for each iterator
 
 			return func() (T, bool) {
 				if !firstExhausted {
@@ -27,12 +26,14 @@ func (s *stream[T]) Concat(other Enumerable[T]) Stream[T] {
 						return val, true
 					}
 					firstExhausted = true
+					otherSource = iteratorOf(other, sc) // Fresh source for other
 				}
 
-				return other.Next()
+				return otherSource()
 			}
 		},
-		size: newSize, // CALCULATED: currentSize + otherSize if both known
+		size:    newSize, // CALCULATED: currentSize + otherSize if both known
+		oneShot: s.oneShot || isOneShot(other),
 	}
 }
 
@@ -48,18 +49,52 @@ func (s *stream[T]) Concat(other Enumerable[T]) Stream[T] {
 //	set2 := []int{3, 4, 5}
 //	union := Union(From(set1), From(set2)).ToSlice()
 //	// [1, 2, 3, 4, 5]
+func Union[T comparable](e1, e2 Enumerable[T]) Stream[T] {
+	return UnionBy(e1, e2, identity[T])
+}
+
+// UnionBy returns the union of two Enumerables, comparing elements by the key extracted by keySelector.
+// For each key only the first element is kept (elements from e1 come first).
+// This is a function (not a method) because methods cannot have their own type parameters.
+//
+// SIZE: Loses size (unknown how many duplicates exist).
+//
+// Example:
+//
+//	type Person struct { ID int; Tags []string }
+//	everyone := UnionBy(From(team1), From(team2), func(p Person) int { return p.ID }).ToSlice()
+func UnionBy[T any, K comparable](e1, e2 Enumerable[T], keySelector func(T) K) Stream[T] {
+	return unionIndexed(e1, e2, keySelector, newMapIndex[K])
+}
+
+// UnionWith returns the union of two Enumerables, using comparer to decide equality.
+// The first element of each set of equal elements is kept (elements from e1 come first).
+// This is a function (not a method) because in Go methods cannot have their own type parameters.
+//
+// SIZE: Loses size (unknown how many duplicates exist).
+//
+// Example:
+//
+//	tags := UnionWith(From([]string{"Go", "Rust"}), From([]string{"go", "Zig"}), StringFoldComparer()).ToSlice()
+//	// ["Go", "Rust", "Zig"]
+func UnionWith[T any](e1, e2 Enumerable[T], comparer EqualityComparer[T]) Stream[T] {
+	return unionIndexed(e1, e2, identity[T], func() keyIndex[T] { return newHashIndex(comparer) })
+}
+
+// unionIndexed is the common implementation of UnionBy and UnionWith.
+// newIndex creates the index that decides which keys were already seen.
 //
 //nolint:gocognit
-func Union[T comparable](e1, e2 Enumerable[T]) Stream[T] {
+func unionIndexed[T, K any](e1, e2 Enumerable[T], keySelector func(T) K, newIndex func() keyIndex[K]) Stream[T] {
 	return &stream[T]{
-		sourceFactory: func() func() (T, bool) {
-			seen := make(map[T]bool)
-			var current = e1
+		sourceFactory: func(sc *scope) func() (T, bool) {
+			seen := newIndex()
+			current := iteratorOf(e1, sc) // Get fresh source
 			secondStarted := false
 
 			return func() (T, bool) {
 				for {
-					val, ok := current.Next()
+					val, ok := current()
 
 					// Switch to second enumerable
 					if !ok {
@@ -67,20 +102,20 @@ func Union[T comparable](e1, e2 Enumerable[T]) Stream[T] {
 							var zero T
 							return zero, false
 						}
-						current = e2
+						current = iteratorOf(e2, sc)
 						secondStarted = true
 						continue
 					}
 
 					// Return only unique elements
-					if !seen[val] {
-						seen[val] = true
+					if _, added := seen.add(keySelector(val)); added {
 						return val, true
 					}
 				}
 			}
 		},
-		size: -1, // LOSE: unknown how many duplicates
+		size:    -1, // LOSE: unknown how many duplicates
+		oneShot: isOneShot(e1) || isOneShot(e2),
 	}
 }
 
@@ -89,78 +124,277 @@ func Union[T comparable](e1, e2 Enumerable[T]) Stream[T] {
 // This is a function (not a method) because methods cannot have their own type constraints.
 //
 // SIZE: Loses size (unknown result count).
-//
-//nolint:gocognit
 func Intersect[T comparable](e1, e2 Enumerable[T]) Stream[T] {
-	return &stream[T]{
-		sourceFactory: func() func() (T, bool) {
-			// Materialize e2 into a set
-			otherSet := make(map[T]bool)
-			for {
-				val, ok := e2.Next()
-				if !ok {
-					break
-				}
-				otherSet[val] = true
+	return IntersectBy(e1, e2, identity[T])
+}
+
+// IntersectBy returns the distinct elements of e1 whose key, extracted by keySelector,
+// also occurs in e2. For each key only the first element of e1 is kept.
+// This is a function (not a method) because methods cannot have their own type parameters.
+//
+// LAZY: e2 is read into a key set on the first Next() of each iteration.
+//
+// SIZE: Loses size (unknown result count).
+//
+// Example:
+//
+//	stillActive := IntersectBy(From(users), From(activeUsers), func(u User) int { return u.ID }).ToSlice()
+func IntersectBy[T any, K comparable](e1, e2 Enumerable[T], keySelector func(T) K) Stream[T] {
+	return filterByKeySet(e1, e2, keySelector, newMapIndex[K], true)
+}
+
+// IntersectWith returns the distinct elements of e1 that are equal, under comparer, to an element of e2.
+// This is a function (not a method) because in Go methods cannot have their own type parameters.
+//
+// LAZY: e2 is indexed on the first Next() of each iteration.
+//
+// SIZE: Loses size (unknown result count).
+//
+// Example:
+//
+//	common := IntersectWith(From([]float64{1.0, 2.0}), From([]float64{1.0000001}), FloatComparer(1e-6)).ToSlice()
+//	// [1.0]
+func IntersectWith[T any](e1, e2 Enumerable[T], comparer EqualityComparer[T]) Stream[T] {
+	return filterByKeySet(e1, e2, identity[T], func() keyIndex[T] { return newHashIndex(comparer) }, true)
+}
+
+// Except returns the difference of Enumerables (elements from current that are not in other).
+// T must be comparable, otherwise code will not compile.
+// This is a function (not a method) because methods cannot have their own type constraints.
+//
+// SIZE: Loses size (unknown result count).
+func Except[T comparable](e1, e2 Enumerable[T]) Stream[T] {
+	return ExceptBy(e1, e2, identity[T])
+}
+
+// ExceptBy returns the distinct elements of e1 whose key, extracted by keySelector,
+// does not occur in e2. For each key only the first element of e1 is kept.
+// This is a function (not a method) because methods cannot have their own type parameters.
+//
+// LAZY: e2 is read into a key set on the first Next() of each iteration.
+//
+// SIZE: Loses size (unknown result count).
+//
+// Example:
+//
+//	removed := ExceptBy(From(before), From(after), func(r Row) string { return r.ID }).ToSlice()
+func ExceptBy[T any, K comparable](e1, e2 Enumerable[T], keySelector func(T) K) Stream[T] {
+	return filterByKeySet(e1, e2, keySelector, newMapIndex[K], false)
+}
+
+// ExceptWith returns the distinct elements of e1 that are not equal, under comparer, to any element of e2.
+// This is a function (not a method) because in Go methods cannot have their own type parameters.
+//
+// LAZY: e2 is indexed on the first Next() of each iteration.
+//
+// SIZE: Loses size (unknown result count).
+//
+// Example:
+//
+//	rest := ExceptWith(From([]string{"Go", "Rust"}), From([]string{"GO"}), StringFoldComparer()).ToSlice()
+//	// ["Rust"]
+func ExceptWith[T any](e1, e2 Enumerable[T], comparer EqualityComparer[T]) Stream[T] {
+	return filterByKeySet(e1, e2, identity[T], func() keyIndex[T] { return newHashIndex(comparer) }, false)
+}
+
+// SymmetricDifference returns the distinct elements that occur in exactly one of the two Enumerables.
+// Elements of e1 come first, then elements of e2, each in source order.
+// T must be comparable, otherwise code will not compile.
+// This is a function (not a method) because methods cannot have their own type constraints.
+//
+// SIZE: Loses size (unknown result count).
+//
+// Example:
+//
+//	diff := SymmetricDifference(From([]int{1, 2, 3}), From([]int{3, 4})).ToSlice()
+//	// [1, 2, 4]
+func SymmetricDifference[T comparable](e1, e2 Enumerable[T]) Stream[T] {
+	return SymmetricDifferenceBy(e1, e2, identity[T])
+}
+
+// SymmetricDifferenceBy returns the distinct elements whose key, extracted by keySelector,
+// occurs in exactly one of the two Enumerables. Elements of e1 come first, then elements of e2.
+// This is a function (not a method) because methods cannot have their own type parameters.
+//
+// LAZY: Both Enumerables are read once, into buffers of elements and keys,
+// on the first Next() of each iteration.
+//
+// SIZE: Loses size (unknown result count).
+func SymmetricDifferenceBy[T any, K comparable](e1, e2 Enumerable[T], keySelector func(T) K) Stream[T] {
+	// buffer reads source once and returns its elements, their keys and the key set
+	buffer := func(source func() (T, bool)) (items []T, keys []K, set map[K]bool) {
+		set = make(map[K]bool)
+		for {
+			val, ok := source()
+			if !ok {
+				return items, keys, set
 			}
+			key := keySelector(val)
+			items = append(items, val)
+			keys = append(keys, key)
+			set[key] = true
+		}
+	}
 
-			seen := make(map[T]bool)
-			return func() (T, bool) {
-				for {
-					val, ok := e1.Next()
-					if !ok {
-						var zero T
-						return zero, false
-					}
+	return &stream[T]{
+		sourceFactory: func(sc *scope) func() (T, bool) {
+			return deferredIterator(func() []T {
+				items1, keys1, set1 := buffer(iteratorOf(e1, sc))
+				items2, keys2, set2 := buffer(iteratorOf(e2, sc))
 
-					if otherSet[val] && !seen[val] {
-						seen[val] = true
-						return val, true
+				var result []T
+				seen := make(map[K]bool)
+				collect := func(items []T, keys []K, other map[K]bool) {
+					for i, key := range keys {
+						if !other[key] && !seen[key] {
+							seen[key] = true
+							result = append(result, items[i])
+						}
 					}
 				}
-			}
+				collect(items1, keys1, set2)
+				collect(items2, keys2, set1)
+				return result
+			})
 		},
-		size: -1, // LOSE: unknown result count
+		size:    -1, // LOSE: unknown result count
+		oneShot: isOneShot(e1) || isOneShot(e2),
 	}
 }
 
-// Except returns the difference of Enumerables (elements from current that are not in other).
+// IntersectAll returns the multiset intersection of two Enumerables.
+// Unlike Intersect, duplicates are kept: an element occurring m times in e1 and n times in e2
+// is returned min(m, n) times, in the order of e1.
+// T must be comparable, otherwise code will not compile.
+// This is a function (not a method) because methods cannot have their own type constraints.
+//
+// LAZY: e2 is counted on the first Next() of each iteration.
+//
+// SIZE: Loses size (unknown result count).
+//
+// Example:
+//
+//	common := IntersectAll(From([]int{1, 1, 1, 2}), From([]int{1, 1, 3})).ToSlice()
+//	// [1, 1]
+func IntersectAll[T comparable](e1, e2 Enumerable[T]) Stream[T] {
+	return filterByCounts(e1, e2, true)
+}
+
+// ExceptAll returns the multiset difference of two Enumerables.
+// Unlike Except, duplicates are kept: each element of e2 cancels at most one equal element of e1,
+// so an element occurring m times in e1 and n times in e2 is returned max(0, m-n) times,
+// in the order of e1 (the first occurrences are the ones cancelled).
 // T must be comparable, otherwise code will not compile.
 // This is a function (not a method) because methods cannot have their own type constraints.
 //
+// LAZY: e2 is counted on the first Next() of each iteration.
+//
 // SIZE: Loses size (unknown result count).
 //
+// Example:
+//
+//	left := ExceptAll(From([]int{1, 1, 1, 2}), From([]int{1, 2})).ToSlice()
+//	// [1, 1]
+func ExceptAll[T comparable](e1, e2 Enumerable[T]) Stream[T] {
+	return filterByCounts(e1, e2, false)
+}
+
+// identity returns its argument; it is the key selector of the comparable set operations.
+func identity[T any](value T) T {
+	return value
+}
+
+// filterByKeySet returns the distinct (by key) elements of e1 whose key is in e2
+// if keep is true, or not in e2 if keep is false. newIndex creates the indexes that decide key equality.
+//
 //nolint:gocognit
-func Except[T comparable](e1, e2 Enumerable[T]) Stream[T] {
+func filterByKeySet[T, K any](
+	e1, e2 Enumerable[T],
+	keySelector func(T) K,
+	newIndex func() keyIndex[K],
+	keep bool,
+) Stream[T] {
 	return &stream[T]{
-		sourceFactory: func() func() (T, bool) {
-			// Materialize e2 into a set
-			otherSet := make(map[T]bool)
-			for {
-				val, ok := e2.Next()
-				if !ok {
-					break
+		sourceFactory: func(sc *scope) func() (T, bool) {
+			var other keyIndex[K]
+			var source func() (T, bool)
+			seen := newIndex()
+			return func() (T, bool) {
+				if other == nil {
+					// Index the keys of e2 on first Next()
+					other = newIndex()
+					otherSource := iteratorOf(e2, sc) // Fresh pass over e2
+					for {
+						val, ok := otherSource()
+						if !ok {
+							break
+						}
+						other.add(keySelector(val))
+					}
+					source = iteratorOf(e1, sc) // Get fresh source
+				}
+				for {
+					val, ok := source()
+					if !ok {
+						var zero T
+						return zero, false
+					}
+
+					key := keySelector(val)
+					if _, found := other.find(key); found != keep {
+						continue
+					}
+					if _, added := seen.add(key); added {
+						return val, true
+					}
 				}
-				otherSet[val] = true
 			}
+		},
+		size:    -1, // LOSE: unknown result count
+		oneShot: isOneShot(e1) || isOneShot(e2),
+	}
+}
 
-			seen := make(map[T]bool)
+// filterByCounts returns the elements of e1 that are matched one-for-one by elements of e2
+// if keep is true, or the ones left unmatched if keep is false.
+func filterByCounts[T comparable](e1, e2 Enumerable[T], keep bool) Stream[T] {
+	return &stream[T]{
+		sourceFactory: func(sc *scope) func() (T, bool) {
+			var counts map[T]int
+			var source func() (T, bool)
 			return func() (T, bool) {
+				if counts == nil {
+					// Count e2 on first Next()
+					counts = make(map[T]int)
+					other := iteratorOf(e2, sc) // Fresh pass over e2
+					for {
+						val, ok := other()
+						if !ok {
+							break
+						}
+						counts[val]++
+					}
+					source = iteratorOf(e1, sc) // Get fresh source
+				}
 				for {
-					val, ok := e1.Next()
+					val, ok := source()
 					if !ok {
 						var zero T
 						return zero, false
 					}
 
-					if !otherSet[val] && !seen[val] {
-						seen[val] = true
+					matched := counts[val] > 0
+					if matched {
+						counts[val]--
+					}
+					if matched == keep {
 						return val, true
 					}
 				}
 			}
 		},
-		size: -1, // LOSE: unknown result count
+		size:    -1, // LOSE: unknown result count
+		oneShot: isOneShot(e1) || isOneShot(e2),
 	}
 }
 
@@ -168,6 +402,14 @@ func Except[T comparable](e1, e2 Enumerable[T]) Stream[T] {
 // The result stream stops when either source is exhausted.
 // This is a function (not a method) because methods cannot have their own type parameters.
 //
+// If the size of either source is known, iteration stops after that many elements without
+// pulling the next element of the other source, so no element of a shared source is lost.
+// Use ZipLongest to keep the tail of the longer source, or ZipExact to reject unequal lengths.
+//
+// NOTE: If both sizes are unknown, the end of e2 is only detected after the next element of e1
+// has been pulled, so that element is lost when e1 is a one-shot source read again afterwards.
+// Use ZipExact or ZipLongest when the sources may be of unknown size and e1 is consumed further.
+//
 // SIZE: Calculated as min(e1Size, e2Size) if both sizes are known, else unknown.
 //
 // Example:
@@ -181,40 +423,34 @@ func Except[T comparable](e1, e2 Enumerable[T]) Stream[T] {
 //	).ToSlice()
 //	// ["1:a", "2:b", "3:c"]
 func Zip[T1, T2, R any](e1 Enumerable[T1], e2 Enumerable[T2], resultSelector func(T1, T2) R) Stream[R] {
-	var size = -1
-	if sizable1, ok1 := e1.(Sizable[T1]); ok1 {
-		if sizable2, ok2 := e2.(Sizable[T2]); ok2 {
-			if s1, known1 := sizable1.Size(); known1 {
-				if s2, known2 := sizable2.Size(); known2 {
-					// Take minimum of both sizes
-					if s1 < s2 {
-						size = s1
-					} else {
-						size = s2
-					}
-				}
-			}
-		}
-	}
+	limit, size := zipBounds(sizeOf(e1), sizeOf(e2))
 
 	return &stream[R]{
-		sourceFactory: func() func() (R, bool) {
+		sourceFactory: func(sc *scope) func() (R, bool) {
+			source1 := iteratorOf(e1, sc) // Get fresh sources
+			source2 := iteratorOf(e2, sc)
+			count := 0
 			return func() (R, bool) {
-				val1, ok1 := e1.Next()
+				var zero R
+				if limit != -1 && count >= limit {
+					return zero, false // Don't pull an element that can't be paired
+				}
+
+				val1, ok1 := source1()
 				if !ok1 {
-					var zero R
 					return zero, false
 				}
 
-				val2, ok2 := e2.Next()
+				val2, ok2 := source2()
 				if !ok2 {
-					var zero R
 					return zero, false
 				}
 
+				count++
 				return resultSelector(val1, val2), true
 			}
 		},
-		size: size, // CALCULATED: min(e1Size, e2Size) if both known
+		size:    size, // CALCULATED: min(e1Size, e2Size) if both known
+		oneShot: isOneShot(e1) || isOneShot(e2),
 	}
 }
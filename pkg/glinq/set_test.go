@@ -159,3 +159,107 @@ func TestZip(t *testing.T) {
 		}
 	})
 }
+
+// setItem is not comparable (it contains a slice), so it needs the By variants
+type setItem struct {
+	ID   int
+	Tags []string
+}
+
+func setItemID(i setItem) int { return i.ID }
+
+func setItemIDs(items []setItem) []int {
+	return Select(From(items), setItemID).ToSlice()
+}
+
+func TestUnionBy(t *testing.T) {
+	a := []setItem{{ID: 1, Tags: []string{"a"}}, {ID: 2}, {ID: 1, Tags: []string{"dup"}}}
+	b := []setItem{{ID: 2, Tags: []string{"b"}}, {ID: 3}}
+
+	result := UnionBy(From(a), From(b), setItemID).ToSlice()
+
+	if ids := setItemIDs(result); !reflect.DeepEqual(ids, []int{1, 2, 3}) {
+		t.Errorf("Expected IDs [1 2 3], got %v", ids)
+	}
+	if !reflect.DeepEqual(result[0].Tags, []string{"a"}) || result[1].Tags != nil {
+		t.Errorf("Expected first occurrence of each key to win, got %v", result)
+	}
+}
+
+func TestIntersectByExceptBy(t *testing.T) {
+	a := From([]setItem{{ID: 1}, {ID: 2}, {ID: 2}, {ID: 3}, {ID: 4}})
+	b := From([]setItem{{ID: 4}, {ID: 2}, {ID: 9}})
+
+	intersect := IntersectBy(a, b, setItemID)
+	assertReiterable(t, "IntersectBy", Select(intersect, setItemID), []int{2, 4})
+
+	except := ExceptBy(a, b, setItemID)
+	assertReiterable(t, "ExceptBy", Select(except, setItemID), []int{1, 3})
+}
+
+func TestSymmetricDifference(t *testing.T) {
+	t.Run("Comparable", func(t *testing.T) {
+		result := SymmetricDifference(From([]int{1, 2, 2, 3}), From([]int{5, 3, 4, 4}))
+		assertReiterable(t, "SymmetricDifference", result, []int{1, 2, 5, 4})
+	})
+
+	t.Run("By key", func(t *testing.T) {
+		result := SymmetricDifferenceBy(
+			From([]setItem{{ID: 1}, {ID: 2}}),
+			From([]setItem{{ID: 2}, {ID: 3}}),
+			setItemID,
+		).ToSlice()
+		if ids := setItemIDs(result); !reflect.DeepEqual(ids, []int{1, 3}) {
+			t.Errorf("Expected IDs [1 3], got %v", ids)
+		}
+	})
+
+	t.Run("Identical inputs", func(t *testing.T) {
+		if n := SymmetricDifference(From([]int{1, 2}), From([]int{2, 1})).Count(); n != 0 {
+			t.Errorf("Expected empty result, got %d elements", n)
+		}
+	})
+
+	t.Run("One-shot inputs are read once", func(t *testing.T) {
+		result := SymmetricDifference[int](&oneShot{items: []int{1, 2, 3}}, &oneShot{items: []int{3, 4}}).ToSlice()
+		if !reflect.DeepEqual(result, []int{1, 2, 4}) {
+			t.Errorf("Expected [1 2 4], got %v", result)
+		}
+	})
+
+	t.Run("Resources are opened once", func(t *testing.T) {
+		h := &handles{}
+		result := SymmetricDifference(countingResource(h, 1, 2, 3), countingResource(h, 3, 4)).ToSlice()
+		if !reflect.DeepEqual(result, []int{1, 2, 4}) {
+			t.Errorf("Expected [1 2 4], got %v", result)
+		}
+		assertReleased(t, h, 2)
+	})
+}
+
+func TestIntersectAll(t *testing.T) {
+	result := IntersectAll(From([]int{1, 1, 1, 2, 3, 2}), From([]int{2, 1, 1, 4, 2, 2}))
+	assertReiterable(t, "IntersectAll", result, []int{1, 1, 2, 2})
+}
+
+func TestExceptAll(t *testing.T) {
+	result := ExceptAll(From([]int{1, 1, 1, 2, 3, 2}), From([]int{1, 2, 4, 1}))
+	assertReiterable(t, "ExceptAll", result, []int{1, 3, 2})
+
+	if n := ExceptAll(From([]int{1, 1}), Empty[int]()).Count(); n != 2 {
+		t.Errorf("Expected duplicates to be kept, got %d elements", n)
+	}
+}
+
+func TestSetOperationsWith(t *testing.T) {
+	a := From([]string{"Go", "Rust", "go", "Zig"})
+	b := From([]string{"RUST", "Odin", "zig"})
+	fold := StringFoldComparer()
+
+	assertReiterable(t, "UnionWith", UnionWith(a, b, fold), []string{"Go", "Rust", "Zig", "Odin"})
+	assertReiterable(t, "IntersectWith", IntersectWith(a, b, fold), []string{"Rust", "Zig"})
+	assertReiterable(t, "ExceptWith", ExceptWith(a, b, fold), []string{"Go"})
+
+	near := IntersectWith(From([]float64{1.0, 2.0, 3.0}), From([]float64{2.0000001, 9.0}), FloatComparer(1e-6))
+	assertReiterable(t, "IntersectWith float", near, []float64{2.0})
+}
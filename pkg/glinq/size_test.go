@@ -6,7 +6,7 @@ import (
 )
 
 // assertSize проверяет что размер известен и равен ожидаемому
-func assertSize(t *testing.T, s Stream[int], expected int, op string) {
+func assertSize[T any](t *testing.T, s Stream[T], expected int, op string) {
 	t.Helper()
 	size, ok := s.Size()
 	if !ok {
@@ -19,7 +19,7 @@ func assertSize(t *testing.T, s Stream[int], expected int, op string) {
 }
 
 // assertNoSize проверяет что размер неизвестен
-func assertNoSize(t *testing.T, s Stream[int], op string) {
+func assertNoSize[T any](t *testing.T, s Stream[T], op string) {
 	t.Helper()
 	_, ok := s.Size()
 	if ok {
@@ -74,10 +74,14 @@ func TestTake_CalculatesSize(t *testing.T) {
 	s3 := s1.Take(10)
 	assertSize(t, s3, 5, "Take (larger than source)")
 
-	// Take with unknown source
-	s4 := s1.Where(func(x int) bool { return x > 0 })
+	// Take with unknown source: n is only an upper bound, and Count and Any
+	// trust a known size, so the size must stay unknown
+	s4 := s1.Where(func(x int) bool { return x > 3 })
 	s5 := s4.Take(3)
-	assertSize(t, s5, 3, "Take (unknown source)")
+	assertNoSize(t, s5, "Take (unknown source)")
+	if s5.Count() != 2 {
+		t.Errorf("Take (unknown source): expected 2 elements, got %d", s5.Count())
+	}
 }
 
 func TestSkip_CalculatesSize(t *testing.T) {
@@ -125,6 +129,32 @@ func TestOrderByDescending_PreservesSize(t *testing.T) {
 	assertSize(t, s2, 5, "OrderByDescending")
 }
 
+func TestOrderByTake_Size(t *testing.T) {
+	compare := func(a, b int) int { return a - b }
+	assertSize(t, From([]int{3, 1, 2}).OrderBy(compare).Take(2), 2, "OrderBy.Take")
+	assertSize(t, From([]int{3, 1, 2}).OrderBy(compare).Take(5), 3, "OrderBy.Take (larger than source)")
+
+	for _, k := range []int{1, 5} {
+		filtered := From([]int{3, 1, 2}).Where(func(x int) bool { return x > 10 })
+		s := filtered.OrderBy(compare).Take(k)
+		assertNoSize(t, s, "Where.OrderBy.Take")
+		if s.Any() || s.Count() != 0 || len(s.ToSlice()) != 0 {
+			t.Errorf("Where.OrderBy.Take(%d): expected no elements, got Any=%v Count=%d", k, s.Any(), s.Count())
+		}
+
+		reversed := filtered.Reverse().Take(k)
+		assertNoSize(t, reversed, "Where.Reverse.Take")
+		if reversed.Any() || reversed.Count() != 0 {
+			t.Errorf("Where.Reverse.Take(%d): expected no elements, got %d", k, reversed.Count())
+		}
+	}
+
+	kept := From([]int{3, 1, 2}).Where(func(x int) bool { return x > 1 }).OrderBy(compare).Take(5)
+	if kept.Count() != 2 || !kept.Any() {
+		t.Errorf("Where.OrderBy.Take(5): expected Count 2, got %d", kept.Count())
+	}
+}
+
 func TestReverse_PreservesSize(t *testing.T) {
 	s1 := From([]int{1, 2, 3, 4})
 	s2 := s1.Reverse()
@@ -196,13 +226,12 @@ func TestGroupBy_Size(t *testing.T) {
 	people := []Person{{25, "Alice"}, {30, "Bob"}, {25, "Charlie"}}
 	s1 := From(people)
 	s2 := GroupBy(s1, func(p Person) int { return p.Age })
-	// GroupBy materializes, so we know the size (number of groups)
-	size, ok := s2.Size()
-	if !ok {
-		t.Error("GroupBy: expected known size")
+	// GroupBy is lazy, so the number of groups is unknown until iteration
+	if _, ok := s2.Size(); ok {
+		t.Error("GroupBy: expected unknown size")
 	}
-	if size != 2 {
-		t.Errorf("GroupBy: expected size 2 (two age groups), got %d", size)
+	if count := s2.Count(); count != 2 {
+		t.Errorf("GroupBy: expected 2 groups (two age groups), got %d", count)
 	}
 }
 
@@ -252,6 +281,13 @@ func TestTakeOrderedBy_Size(t *testing.T) {
 	// TakeOrderedBy with size larger than source
 	s3 := TakeOrderedBy(s1, 10, func(a, b int) bool { return a < b })
 	assertSize(t, s3, 6, "TakeOrderedBy (larger than source)")
+
+	// TakeOrderedBy with unknown source
+	s4 := TakeOrderedBy(s1.Where(func(x int) bool { return x > 100 }), 3, func(a, b int) bool { return a < b })
+	assertNoSize(t, s4, "TakeOrderedBy (unknown source)")
+	if s4.Count() != 0 {
+		t.Errorf("TakeOrderedBy (unknown source): expected 0, got %d", s4.Count())
+	}
 }
 
 func TestCount_Optimization(t *testing.T) {
@@ -329,9 +365,9 @@ func TestSizeHandling_Chain(t *testing.T) {
 	s4 := s1.Take(3)
 	assertSize(t, s4, 3, "Take")
 
-	// Take with unknown source
+	// Take with unknown source (3 elements, not 10)
 	s5 := s3.Take(10)
-	assertSize(t, s5, 10, "Take with unknown source")
+	assertNoSize(t, s5, "Take with unknown source")
 
 	// Skip calculates size
 	s6 := s1.Skip(2)
@@ -0,0 +1,150 @@
+package glinq
+
+import (
+	"math"
+	"slices"
+)
+
+// SumBy calculates the sum of the values extracted by selector.
+// Returns zero value if Enumerable is empty.
+//
+// Example:
+//
+//	type Order struct { Item string; Price float64 }
+//	total := SumBy(From(orders), func(o Order) float64 { return o.Price })
+func SumBy[T any, N Numeric](enum Enumerable[T], selector func(T) N) N {
+	iterator, sc := openEnumerable(enum) // Fresh iterator if possible
+	defer sc.close()
+	var sum N
+	for {
+		value, ok := iterator()
+		if !ok {
+			break
+		}
+		sum += selector(value)
+	}
+	return sum
+}
+
+// Average calculates the arithmetic mean of all elements.
+// Returns 0 and false if Enumerable is empty.
+//
+// Example:
+//
+//	avg, ok := Average(From([]int{1, 2, 3, 4}))
+//	// avg = 2.5, ok = true
+func Average[T Numeric](enum Enumerable[T]) (float64, bool) {
+	n, mean, _ := welford(enum, func(x T) float64 { return float64(x) })
+	return mean, n > 0
+}
+
+// AverageBy calculates the arithmetic mean of the values extracted by selector.
+// Returns 0 and false if Enumerable is empty.
+//
+// Example:
+//
+//	avgPrice, ok := AverageBy(From(orders), func(o Order) float64 { return o.Price })
+func AverageBy[T any, N Numeric](enum Enumerable[T], selector func(T) N) (float64, bool) {
+	n, mean, _ := welford(enum, func(x T) float64 { return float64(selector(x)) })
+	return mean, n > 0
+}
+
+// Variance calculates the population variance of all elements in a single pass (Welford's algorithm).
+// Returns 0 and false if Enumerable is empty.
+//
+// Example:
+//
+//	v, ok := Variance(From([]float64{2, 4, 4, 4, 5, 5, 7, 9}))
+//	// v = 4, ok = true
+func Variance[T Numeric](enum Enumerable[T]) (float64, bool) {
+	n, _, m2 := welford(enum, func(x T) float64 { return float64(x) })
+	if n == 0 {
+		return 0, false
+	}
+	return m2 / float64(n), true
+}
+
+// SampleVariance calculates the sample variance (Bessel's correction, n-1 denominator)
+// of all elements in a single pass (Welford's algorithm).
+// Returns 0 and false if Enumerable has fewer than two elements.
+func SampleVariance[T Numeric](enum Enumerable[T]) (float64, bool) {
+	n, _, m2 := welford(enum, func(x T) float64 { return float64(x) })
+	if n < 2 {
+		return 0, false
+	}
+	return m2 / float64(n-1), true
+}
+
+// StdDev calculates the population standard deviation of all elements.
+// Returns 0 and false if Enumerable is empty.
+func StdDev[T Numeric](enum Enumerable[T]) (float64, bool) {
+	v, ok := Variance(enum)
+	return math.Sqrt(v), ok
+}
+
+// SampleStdDev calculates the sample standard deviation of all elements.
+// Returns 0 and false if Enumerable has fewer than two elements.
+func SampleStdDev[T Numeric](enum Enumerable[T]) (float64, bool) {
+	v, ok := SampleVariance(enum)
+	return math.Sqrt(v), ok
+}
+
+// Median returns the middle value of all elements.
+// For an even number of elements it returns the mean of the two middle values.
+// Returns 0 and false if Enumerable is empty.
+//
+// NOTE: Median materializes and sorts the elements.
+//
+// Example:
+//
+//	m, ok := Median(From([]int{5, 1, 4, 2}))
+//	// m = 3, ok = true
+func Median[T Numeric](enum Enumerable[T]) (float64, bool) {
+	return Percentile(enum, 50)
+}
+
+// Percentile returns the p-th percentile (0 <= p <= 100) of all elements,
+// linearly interpolating between the two closest ranks.
+// Percentile(enum, 0) is the minimum and Percentile(enum, 100) the maximum.
+// Returns 0 and false if Enumerable is empty or p is out of range.
+//
+// NOTE: Percentile materializes and sorts the elements.
+//
+// Example:
+//
+//	p90, ok := Percentile(From(latencies), 90)
+func Percentile[T Numeric](enum Enumerable[T], p float64) (float64, bool) {
+	if p < 0 || p > 100 || math.IsNaN(p) {
+		return 0, false
+	}
+
+	values := FromEnumerable(enum).ToSlice()
+	if len(values) == 0 {
+		return 0, false
+	}
+	slices.Sort(values)
+
+	rank := p / 100 * float64(len(values)-1)
+	lower := int(math.Floor(rank))
+	upper := int(math.Ceil(rank))
+	fraction := rank - float64(lower)
+	return float64(values[lower]) + (float64(values[upper])-float64(values[lower]))*fraction, true
+}
+
+// welford computes the count, mean and sum of squared deviations of the selected values
+// in a single numerically stable pass.
+func welford[T any](enum Enumerable[T], selector func(T) float64) (n int, mean, m2 float64) {
+	iterator, sc := openEnumerable(enum)
+	defer sc.close()
+	for {
+		value, ok := iterator()
+		if !ok {
+			return n, mean, m2
+		}
+		x := selector(value)
+		n++
+		delta := x - mean
+		mean += delta / float64(n)
+		m2 += delta * (x - mean)
+	}
+}
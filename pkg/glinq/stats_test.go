@@ -0,0 +1,102 @@
+package glinq
+
+import (
+	"math"
+	"testing"
+)
+
+func assertFloat(t *testing.T, name string, got float64, ok bool, expected float64) {
+	t.Helper()
+	if !ok {
+		t.Errorf("%s: expected ok, got false", name)
+		return
+	}
+	if math.Abs(got-expected) > 1e-9 {
+		t.Errorf("%s: expected %v, got %v", name, expected, got)
+	}
+}
+
+func TestAverage(t *testing.T) {
+	avg, ok := Average(From([]int{1, 2, 3, 4}))
+	assertFloat(t, "Average", avg, ok, 2.5)
+
+	if _, ok := Average(Empty[int]()); ok {
+		t.Error("Average: expected false for empty stream")
+	}
+}
+
+func TestSumByAverageBy(t *testing.T) {
+	type Order struct {
+		Item  string
+		Price float64
+		Qty   int
+	}
+	orders := From([]Order{{"a", 1.5, 2}, {"b", 2.5, 3}, {"c", 5, 1}})
+
+	if total := SumBy(orders, func(o Order) int { return o.Qty }); total != 6 {
+		t.Errorf("SumBy: expected 6, got %d", total)
+	}
+	avg, ok := AverageBy(orders, func(o Order) float64 { return o.Price })
+	assertFloat(t, "AverageBy", avg, ok, 3)
+
+	if _, ok := AverageBy(Empty[Order](), func(o Order) int { return o.Qty }); ok {
+		t.Error("AverageBy: expected false for empty stream")
+	}
+}
+
+func TestVarianceAndStdDev(t *testing.T) {
+	data := From([]float64{2, 4, 4, 4, 5, 5, 7, 9})
+
+	v, ok := Variance(data)
+	assertFloat(t, "Variance", v, ok, 4)
+	sd, ok := StdDev(data)
+	assertFloat(t, "StdDev", sd, ok, 2)
+	sv, ok := SampleVariance(data)
+	assertFloat(t, "SampleVariance", sv, ok, 32.0/7)
+	ssd, ok := SampleStdDev(data)
+	assertFloat(t, "SampleStdDev", ssd, ok, math.Sqrt(32.0/7))
+
+	t.Run("Single element", func(t *testing.T) {
+		v, ok := Variance(From([]int{42}))
+		assertFloat(t, "Variance", v, ok, 0)
+		if _, ok := SampleVariance(From([]int{42})); ok {
+			t.Error("SampleVariance: expected false for a single element")
+		}
+	})
+
+	t.Run("Numerically stable with large offset", func(t *testing.T) {
+		v, ok := Variance(From([]float64{1e9 + 4, 1e9 + 7, 1e9 + 13, 1e9 + 16}))
+		assertFloat(t, "Variance", v, ok, 22.5)
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		if _, ok := StdDev(Empty[int]()); ok {
+			t.Error("StdDev: expected false for empty stream")
+		}
+	})
+}
+
+func TestMedianAndPercentile(t *testing.T) {
+	m, ok := Median(From([]int{5, 1, 4, 2}))
+	assertFloat(t, "Median (even)", m, ok, 3)
+	m, ok = Median(From([]int{5, 1, 3}))
+	assertFloat(t, "Median (odd)", m, ok, 3)
+
+	data := Range(1, 10) // 1..10
+	p, ok := Percentile(data, 0)
+	assertFloat(t, "Percentile 0", p, ok, 1)
+	p, ok = Percentile(data, 100)
+	assertFloat(t, "Percentile 100", p, ok, 10)
+	p, ok = Percentile(data, 90)
+	assertFloat(t, "Percentile 90", p, ok, 9.1)
+
+	if _, ok := Percentile(data, 101); ok {
+		t.Error("Percentile: expected false for p > 100")
+	}
+	if _, ok := Percentile(data, -1); ok {
+		t.Error("Percentile: expected false for p < 0")
+	}
+	if _, ok := Median(Empty[float64]()); ok {
+		t.Error("Median: expected false for empty stream")
+	}
+}
@@ -1,5 +1,11 @@
 package glinq
 
+import (
+	"context"
+	"io"
+	"iter"
+)
+
 // Enumerable is the minimal interface for iterable collections.
 // Any type that can provide a sequence of elements.
 type Enumerable[T any] interface {
@@ -20,6 +26,37 @@ type Sizable[T any] interface {
 	Size() (int, bool)
 }
 
+// Restartable extends Enumerable with the ability to start a fresh pass over the elements.
+// This is an optional interface for custom Enumerables. Operators implemented as functions
+// use it so that their results can be iterated more than once. Enumerables that are not
+// Restartable are consumed through Next and can be iterated once.
+//
+// Streams do not implement Restartable: they restart internally when their source can, and a
+// Stream over a one-shot source (FromChannel, FromChannelContext, FromSeq, or FromEnumerable
+// over a non-Restartable Enumerable) continues reading the same source on every iteration.
+type Restartable[T any] interface {
+	Enumerable[T]
+
+	// Iterator returns a new iterator positioned before the first element.
+	// The iterator returns the next element and true, or zero value and false when exhausted.
+	Iterator() func() (T, bool)
+}
+
+// Indexable extends Enumerable with constant-time random access by position.
+// This is an optional interface - not all Enumerables need to implement it.
+// Slice-backed streams (From, FromSafe), Range and Repeat are indexable internally, and
+// the capability is propagated through Select, SelectWithIndex, Take, Skip and Reverse,
+// so ElementAt, Last, Skip and Reverse avoid iterating. Implement it on custom
+// Enumerables to get the same fast paths after FromEnumerable.
+type Indexable[T any] interface {
+	Enumerable[T]
+
+	// At returns the element at index, which is guaranteed to be in [0, Len()).
+	At(index int) T
+	// Len returns the number of elements.
+	Len() int
+}
+
 // Stream extends Enumerable and adds operators for working with sequences.
 type Stream[T any] interface {
 	Enumerable[T] // Embed Enumerable
@@ -58,6 +95,21 @@ type Stream[T any] interface {
 	ToSlice() []T
 	// First returns the first element and true, or zero value and false if Stream is empty.
 	First() (T, bool)
+	// FirstWhere returns the first element satisfying the predicate and true,
+	// or zero value and false if no element satisfies it.
+	FirstWhere(predicate func(T) bool) (T, bool)
+	// FirstOrDefault returns the first element, or defaultValue if Stream is empty.
+	FirstOrDefault(defaultValue T) T
+	// Single returns the only element of the Stream.
+	// Returns ErrNoElements if the Stream is empty and ErrMoreThanOneElement if it has more than one element.
+	//
+	// Example:
+	//   value, err := From([]int{42}).Single()
+	//   // value = 42, err = nil
+	Single() (T, error)
+	// SingleOrDefault returns the only element of the Stream, or defaultValue if the Stream is empty.
+	// Returns ErrMoreThanOneElement if the Stream has more than one element.
+	SingleOrDefault(defaultValue T) (T, error)
 	// Count returns the number of elements in Stream.
 	Count() int
 	// Any checks if there is at least one element in the Stream.
@@ -73,6 +125,11 @@ type Stream[T any] interface {
 	Chunk(size int) [][]T
 	// Last returns the last element and true, or zero value and false if Stream is empty.
 	Last() (T, bool)
+	// LastWhere returns the last element satisfying the predicate and true,
+	// or zero value and false if no element satisfies it.
+	LastWhere(predicate func(T) bool) (T, bool)
+	// LastOrDefault returns the last element, or defaultValue if Stream is empty.
+	LastOrDefault(defaultValue T) T
 	// ElementAt returns the element at the specified index and true, or zero value and false if index is out of range.
 	// Index is zero-based. Negative indices are treated as out of range.
 	//
@@ -122,14 +179,16 @@ type Stream[T any] interface {
 	// OrderBy sorts elements using a comparator function.
 	// Comparator should return: negative value if a < b,
 	// 0 if a == b, positive if a > b.
-	// NOTE: OrderBy materializes the entire stream for sorting (partially lazy).
+	// The sort is stable; add secondary keys with ThenBy / ThenByDescending.
+	// NOTE: Sorting is deferred to the first Next() and then materializes the entire stream (partially lazy).
+	// OrderBy(...).Take(n) keeps only n elements in a heap instead of sorting everything.
 	//
 	// Example:
 	//   sorted := From([]int{5, 2, 8}).
 	//       OrderBy(func(a, b int) int { return a - b }).
 	//       ToSlice()
 	//   // [2, 5, 8]
-	OrderBy(comparator func(T, T) int) Stream[T]
+	OrderBy(comparator func(T, T) int) OrderedStream[T]
 	// OrderByDescending sorts elements in reverse order.
 	// This is a shortcut for OrderBy with inverted comparator.
 	//
@@ -138,7 +197,7 @@ type Stream[T any] interface {
 	//       OrderByDescending(func(a, b int) int { return a - b }).
 	//       ToSlice()
 	//   // [8, 5, 2]
-	OrderByDescending(comparator func(T, T) int) Stream[T]
+	OrderByDescending(comparator func(T, T) int) OrderedStream[T]
 	// DistinctBy removes duplicates by key extracted by keySelector.
 	// keySelector should return a comparable value.
 	// RUNTIME REQUIREMENT: returned value must be comparable,
@@ -160,7 +219,8 @@ type Stream[T any] interface {
 	//   // [1, 2, 2, 3]
 	Concat(other Enumerable[T]) Stream[T]
 	// Reverse reverses the order of elements in the Stream.
-	// NOTE: Reverse materializes the entire stream (partially lazy).
+	// OPTIMIZATION: Indexable sources (slices, Range) are read backwards by index without copying.
+	// NOTE: Other sources are deferred to the first Next() and then materialized (partially lazy).
 	//
 	// Example:
 	//   reversed := From([]int{1, 2, 3, 4}).
@@ -168,13 +228,38 @@ type Stream[T any] interface {
 	//       ToSlice()
 	//   // [4, 3, 2, 1]
 	Reverse() Stream[T]
+	// Seq returns the Stream as a range-over-func iterator.
+	// Each range over the returned iter.Seq starts a fresh iteration.
+	//
+	// Example:
+	//   for x := range From([]int{1, 2, 3}).Seq() {
+	//       fmt.Println(x)
+	//   }
+	Seq() iter.Seq[T]
+	// Close releases the resources held by an iteration started with Next (see FromResource).
+	// Terminal operations, Seq and ToChannel release their resources themselves;
+	// Close is only needed when a Stream consumed through Next is abandoned before it is exhausted.
+	// After Close, Next reports no more elements.
+	Close() error
+	// ToChannel runs the Stream in a new goroutine and sends its elements to the returned channel,
+	// which is closed when the Stream is exhausted or ctx is cancelled.
+	// buffer is the channel capacity; a full buffer blocks the producer.
+	//
+	// Example:
+	//   for x := range From(items).Select(process).ToChannel(ctx, 16) {
+	//       consume(x)
+	//   }
+	ToChannel(ctx context.Context, buffer int) <-chan T
 }
 
 // stream represents the internal implementation of Stream.
 type stream[T any] struct {
-	sourceFactory   func() func() (T, bool)
-	currentIterator func() (T, bool) // For Enumerable.Next()
-	size            int              // -1 if unknown, actual size if known
+	sourceFactory   func(sc *scope) func() (T, bool) // Resources opened by an iterator are registered in sc
+	currentIterator func() (T, bool)                 // For Enumerable.Next()
+	nextScope       *scope                           // Resources of the Next() iteration
+	size            int                              // -1 if unknown, actual size if known
+	at              func(int) T                      // Random access for indexes in [0, size), nil if not indexable
+	oneShot         bool                             // Iterations continue reading one source instead of starting over
 }
 
 // From creates a Stream from a slice.
@@ -192,7 +277,7 @@ type stream[T any] struct {
 //	// Efficient - no copying!
 func From[T any](slice []T) Stream[T] {
 	return &stream[T]{
-		sourceFactory: func() func() (T, bool) {
+		sourceFactory: func(sc *scope) func() (T, bool) {
 			index := 0 // Fresh index for each iterator
 			return func() (T, bool) {
 				if index >= len(slice) {
@@ -205,6 +290,7 @@ func From[T any](slice []T) Stream[T] {
 			}
 		},
 		size: len(slice),
+		at:   func(i int) T { return slice[i] },
 	}
 }
 
@@ -227,7 +313,7 @@ func FromSafe[T any](slice []T) Stream[T] {
 	copy(data, slice)
 
 	return &stream[T]{
-		sourceFactory: func() func() (T, bool) {
+		sourceFactory: func(sc *scope) func() (T, bool) {
 			index := 0 // Fresh index for each iterator
 			return func() (T, bool) {
 				if index >= len(data) {
@@ -240,13 +326,14 @@ func FromSafe[T any](slice []T) Stream[T] {
 			}
 		},
 		size: len(data),
+		at:   func(i int) T { return data[i] },
 	}
 }
 
 // Empty creates an empty Stream that contains no elements.
 func Empty[T any]() Stream[T] {
 	return &stream[T]{
-		sourceFactory: func() func() (T, bool) {
+		sourceFactory: func(sc *scope) func() (T, bool) {
 			return func() (T, bool) {
 				var zero T
 				return zero, false
@@ -264,7 +351,7 @@ func Range(start, count int) Stream[int] {
 	}
 
 	return &stream[int]{
-		sourceFactory: func() func() (int, bool) {
+		sourceFactory: func(sc *scope) func() (int, bool) {
 			index := 0 // Fresh index for each iterator
 			return func() (int, bool) {
 				if index >= count {
@@ -276,15 +363,70 @@ func Range(start, count int) Stream[int] {
 			}
 		},
 		size: count,
+		at:   func(i int) int { return start + i },
 	}
 }
 
-// Next implements Enumerable
+// deferredIterator returns an iterator that calls materialize on its first pull
+// and then yields the elements of the resulting slice.
+// Used by operators that need the whole input (sorting, reversing) to stay lazy.
+func deferredIterator[T any](materialize func() []T) func() (T, bool) {
+	var items []T
+	materialized := false
+	index := 0
+	return func() (T, bool) {
+		if !materialized {
+			items = materialize()
+			materialized = true
+		}
+		if index >= len(items) {
+			var zero T
+			return zero, false
+		}
+		result := items[index]
+		index++
+		return result, true
+	}
+}
+
+// indexedIterator returns an iterator over at(0), ..., at(size-1).
+func indexedIterator[T any](at func(int) T, size int) func() (T, bool) {
+	index := 0
+	return func() (T, bool) {
+		if index >= size {
+			var zero T
+			return zero, false
+		}
+		result := at(index)
+		index++
+		return result, true
+	}
+}
+
+// Next implements Enumerable.
+// Resources are released when the Stream is exhausted or closed.
 func (s *stream[T]) Next() (T, bool) {
 	if s.currentIterator == nil {
-		s.currentIterator = s.sourceFactory()
+		s.nextScope = &scope{}
+		s.currentIterator = s.sourceFactory(s.nextScope)
+	}
+	value, ok := s.currentIterator()
+	if !ok {
+		s.nextScope.close()
 	}
-	return s.currentIterator()
+	return value, ok
+}
+
+// Close releases the resources of the Next() iteration and ends it.
+func (s *stream[T]) Close() error {
+	if s.nextScope != nil {
+		s.nextScope.close()
+	}
+	s.currentIterator = func() (T, bool) {
+		var zero T
+		return zero, false
+	}
+	return nil
 }
 
 // Size implements Sizable
@@ -295,19 +437,122 @@ func (s *stream[T]) Size() (int, bool) {
 	return s.size, true
 }
 
-// FromEnumerable creates a Stream from any Enumerable.
-// SIZE: Preserves size if source is Sizable, otherwise unknown.
-func FromEnumerable[T any](enum Enumerable[T]) Stream[T] {
-	size := -1
+// iterate starts a fresh iteration whose resources are registered in sc.
+func (s *stream[T]) iterate(sc *scope) func() (T, bool) {
+	return s.sourceFactory(sc)
+}
+
+// open starts a fresh iteration for a terminal operation.
+// The caller must close the returned scope when done, usually with defer,
+// so resources are released on exhaustion, early exit and panic alike.
+func (s *stream[T]) open() (func() (T, bool), *scope) {
+	sc := &scope{}
+	return s.sourceFactory(sc), sc
+}
+
+// iteratorOf returns a fresh iterator over enum whose resources are registered in sc.
+// Streams and Restartable Enumerables start a fresh pass; other Enumerables are
+// consumed through Next (single pass), and closed with sc if they implement io.Closer.
+func iteratorOf[T any](enum Enumerable[T], sc *scope) func() (T, bool) {
+	if scoped, ok := enum.(interface {
+		iterate(sc *scope) func() (T, bool)
+	}); ok {
+		return scoped.iterate(sc)
+	}
+	if restartable, ok := enum.(Restartable[T]); ok {
+		return restartable.Iterator()
+	}
+	if closer, ok := enum.(io.Closer); ok {
+		sc.onClose(func() { _ = closer.Close() })
+	}
+	return enum.Next
+}
+
+// openEnumerable starts a fresh iteration over enum for a terminal operation (see open).
+func openEnumerable[T any](enum Enumerable[T]) (func() (T, bool), *scope) {
+	sc := &scope{}
+	return iteratorOf(enum, sc), sc
+}
+
+// scope collects the release functions of the resources opened during one iteration.
+// Operators pass the scope of the iteration they belong to down to their sources;
+// the terminal operation that created it closes it.
+type scope struct {
+	releases []func()
+}
+
+// onClose registers release to run when the scope is closed.
+func (sc *scope) onClose(release func()) {
+	sc.releases = append(sc.releases, release)
+}
+
+// close runs the registered release functions in reverse order, at most once each.
+func (sc *scope) close() {
+	for len(sc.releases) > 0 {
+		last := len(sc.releases) - 1
+		release := sc.releases[last]
+		sc.releases = sc.releases[:last]
+		release()
+	}
+}
+
+// isOneShot reports whether iterating enum again may continue where the previous iteration
+// stopped instead of starting over. Streams know it from their sources; other Enumerables
+// start over only if they are Restartable.
+func isOneShot[T any](enum Enumerable[T]) bool {
+	if s, ok := enum.(interface{ oneShotSource() bool }); ok {
+		return s.oneShotSource()
+	}
+	_, restartable := enum.(Restartable[T])
+	return !restartable
+}
+
+// oneShotSource exposes whether the stream reads a one-shot source (see isOneShot).
+func (s *stream[T]) oneShotSource() bool {
+	return s.oneShot
+}
+
+// sizeOf returns the known size of enum if it is Sizable or Indexable, otherwise -1.
+func sizeOf[T any](enum Enumerable[T]) int {
+	if indexable, ok := enum.(Indexable[T]); ok {
+		return indexable.Len()
+	}
 	if sizable, ok := enum.(Sizable[T]); ok {
 		if s, known := sizable.Size(); known {
-			size = s
+			return s
 		}
 	}
+	return -1
+}
+
+// indexAt exposes the internal random access of a stream (nil if not indexable).
+func (s *stream[T]) indexAt() func(int) T {
+	return s.at
+}
+
+// indexOf returns random access into enum if it is an indexable stream or implements Indexable,
+// otherwise nil. When it is not nil, sizeOf(enum) is the number of valid indexes.
+func indexOf[T any](enum Enumerable[T]) func(int) T {
+	if indexed, ok := enum.(interface{ indexAt() func(int) T }); ok {
+		return indexed.indexAt()
+	}
+	if indexable, ok := enum.(Indexable[T]); ok {
+		return indexable.At
+	}
+	return nil
+}
+
+// FromEnumerable creates a Stream from any Enumerable.
+// If enum is a re-iterable Stream or Restartable, the Stream is re-iterable; otherwise it can be consumed once.
+// SIZE: Preserves size if source is Sizable, otherwise unknown.
+// Random access is preserved if source is Indexable.
+func FromEnumerable[T any](enum Enumerable[T]) Stream[T] {
 	return &stream[T]{
-		sourceFactory: func() func() (T, bool) {
-			return enum.Next
+		sourceFactory: func(sc *scope) func() (T, bool) {
+			return iteratorOf(enum, sc) // Fresh source if possible
 		},
-		size: size,
+		size:    sizeOf(enum),
+		at:      indexOf(enum),
+		oneShot: isOneShot(enum),
 	}
 }
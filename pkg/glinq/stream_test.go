@@ -156,3 +156,95 @@ func TestStreamReusabilityWithOperators(t *testing.T) {
 		t.Errorf("Expected length %d, got %d", len(expected), len(evens2))
 	}
 }
+
+// indexedInts is a custom Indexable used to check that FromEnumerable keeps random access.
+type indexedInts struct {
+	items []int
+	reads int
+	next  int
+}
+
+func (x *indexedInts) Next() (int, bool) {
+	if x.next >= len(x.items) {
+		return 0, false
+	}
+	x.reads++
+	x.next++
+	return x.items[x.next-1], true
+}
+
+func (x *indexedInts) At(index int) int { return x.items[index] }
+
+func (x *indexedInts) Len() int { return len(x.items) }
+
+func TestIndexableFastPaths(t *testing.T) {
+	t.Run("Select maps only the accessed element", func(t *testing.T) {
+		calls := 0
+		s := From([]int{1, 2, 3, 4, 5}).Select(func(x int) int {
+			calls++
+			return x * 10
+		})
+
+		if value, ok := s.ElementAt(3); !ok || value != 40 {
+			t.Errorf("ElementAt: expected 40, got %d (ok=%v)", value, ok)
+		}
+		if value, ok := s.Last(); !ok || value != 50 {
+			t.Errorf("Last: expected 50, got %d (ok=%v)", value, ok)
+		}
+		if calls != 2 {
+			t.Errorf("Expected mapper to run 2 times, ran %d", calls)
+		}
+	})
+
+	t.Run("Skip Take Reverse over Range", func(t *testing.T) {
+		s := Range(0, 1_000_000).Skip(10).Take(5).Reverse()
+		assertReiterable(t, "Skip.Take.Reverse", s, []int{14, 13, 12, 11, 10})
+		if value := s.ElementAtOrDefault(1, -1); value != 13 {
+			t.Errorf("ElementAtOrDefault: expected 13, got %d", value)
+		}
+		if value := s.ElementAtOrDefault(5, -1); value != -1 {
+			t.Errorf("ElementAtOrDefault: expected -1, got %d", value)
+		}
+	})
+
+	t.Run("SelectWithIndex and Repeat", func(t *testing.T) {
+		s := SelectWithIndex(Repeat("x", 4), func(v string, i int) string { return v + string(rune('a'+i)) })
+		if value, ok := s.ElementAt(2); !ok || value != "xc" {
+			t.Errorf("ElementAt: expected xc, got %q (ok=%v)", value, ok)
+		}
+		assertReiterable(t, "Reverse", s.Reverse(), []string{"xd", "xc", "xb", "xa"})
+	})
+
+	t.Run("Reverse is re-iterable and sees the slice", func(t *testing.T) {
+		data := []int{1, 2, 3}
+		s := From(data).Reverse()
+		data[0] = 9
+		assertReiterable(t, "Reverse", s, []int{3, 2, 9})
+	})
+
+	t.Run("Custom Indexable via FromEnumerable", func(t *testing.T) {
+		source := &indexedInts{items: []int{5, 6, 7, 8}}
+		s := FromEnumerable[int](source)
+
+		if size, ok := s.Size(); !ok || size != 4 {
+			t.Errorf("Size: expected 4, got %d (ok=%v)", size, ok)
+		}
+		if value, ok := s.Skip(2).Last(); !ok || value != 8 {
+			t.Errorf("Last: expected 8, got %d (ok=%v)", value, ok)
+		}
+		if value, ok := s.ElementAt(1); !ok || value != 6 {
+			t.Errorf("ElementAt: expected 6, got %d (ok=%v)", value, ok)
+		}
+		if source.reads != 0 {
+			t.Errorf("Expected no sequential reads, got %d", source.reads)
+		}
+	})
+
+	t.Run("Where drops random access", func(t *testing.T) {
+		s := From([]int{1, 2, 3, 4}).Where(func(x int) bool { return x%2 == 0 })
+		if value, ok := s.Last(); !ok || value != 4 {
+			t.Errorf("Last: expected 4, got %d (ok=%v)", value, ok)
+		}
+		assertReiterable(t, "Where.Reverse", s.Reverse(), []int{4, 2})
+	})
+}
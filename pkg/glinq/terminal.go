@@ -1,11 +1,10 @@
 package glinq
 
-import "reflect"
-
 // ToSlice materializes Stream into a slice.
 // OPTIMIZATION: Preallocates capacity if size is known.
 func (s *stream[T]) ToSlice() []T {
-	iterator := s.sourceFactory() // Fresh iterator
+	iterator, sc := s.open() // Fresh iterator
+	defer sc.close()
 	var result []T
 	// OPTIMIZATION: preallocate if size known
 	if s.size != -1 {
@@ -23,10 +22,90 @@ func (s *stream[T]) ToSlice() []T {
 
 // First returns the first element and true, or zero value and false if Stream is empty.
 func (s *stream[T]) First() (T, bool) {
-	iterator := s.sourceFactory() // Fresh iterator
+	iterator, sc := s.open() // Fresh iterator
+	defer sc.close()
 	return iterator()
 }
 
+// FirstWhere returns the first element satisfying the predicate and true,
+// or zero value and false if no element satisfies it.
+func (s *stream[T]) FirstWhere(predicate func(T) bool) (T, bool) {
+	iterator, sc := s.open() // Fresh iterator
+	defer sc.close()
+	for {
+		value, ok := iterator()
+		if !ok {
+			return value, false
+		}
+		if predicate(value) {
+			return value, true
+		}
+	}
+}
+
+// FirstOrDefault returns the first element, or defaultValue if Stream is empty.
+func (s *stream[T]) FirstOrDefault(defaultValue T) T {
+	if value, ok := s.First(); ok {
+		return value
+	}
+	return defaultValue
+}
+
+// Single returns the only element of the Stream.
+// Returns ErrNoElements if the Stream is empty and ErrMoreThanOneElement if it has more than one element.
+//
+// Iterates at most two elements.
+//
+// Example:
+//
+//	admin, err := From(users).Where(isAdmin).Single()
+//	if errors.Is(err, ErrMoreThanOneElement) { ... }
+func (s *stream[T]) Single() (T, error) {
+	value, count := s.single()
+	switch count {
+	case 0:
+		return value, ErrNoElements
+	case 1:
+		return value, nil
+	default:
+		return value, ErrMoreThanOneElement
+	}
+}
+
+// SingleOrDefault returns the only element of the Stream, or defaultValue if the Stream is empty.
+// Returns ErrMoreThanOneElement if the Stream has more than one element.
+func (s *stream[T]) SingleOrDefault(defaultValue T) (T, error) {
+	value, count := s.single()
+	switch count {
+	case 0:
+		return defaultValue, nil
+	case 1:
+		return value, nil
+	default:
+		return value, ErrMoreThanOneElement
+	}
+}
+
+// single returns the only element and 1, or zero value and the number of elements seen (0 or 2).
+func (s *stream[T]) single() (T, int) {
+	var zero T
+	// OPTIMIZATION: If size is known and it's 0, return immediately
+	if s.size == 0 {
+		return zero, 0
+	}
+
+	iterator, sc := s.open() // Fresh iterator
+	defer sc.close()
+	value, ok := iterator()
+	if !ok {
+		return zero, 0
+	}
+	if _, more := iterator(); more {
+		return zero, 2
+	}
+	return value, 1
+}
+
 // Count returns the number of elements in Stream.
 // OPTIMIZATION: Returns O(1) if size is known, otherwise O(n).
 func (s *stream[T]) Count() int {
@@ -36,7 +115,8 @@ func (s *stream[T]) Count() int {
 	}
 
 	// Fallback: iterate and count
-	iterator := s.sourceFactory() // Fresh iterator
+	iterator, sc := s.open() // Fresh iterator
+	defer sc.close()
 	count := 0
 	for {
 		_, ok := iterator()
@@ -57,14 +137,16 @@ func (s *stream[T]) Any() bool {
 	}
 
 	// Fallback: iterate until first element
-	iterator := s.sourceFactory() // Fresh iterator
+	iterator, sc := s.open() // Fresh iterator
+	defer sc.close()
 	_, ok := iterator()
 	return ok
 }
 
 // AnyMatch checks if there is at least one element satisfying the predicate.
 func (s *stream[T]) AnyMatch(predicate func(T) bool) bool {
-	iterator := s.sourceFactory() // Fresh iterator
+	iterator, sc := s.open() // Fresh iterator
+	defer sc.close()
 	for {
 		value, ok := iterator()
 		if !ok {
@@ -79,7 +161,8 @@ func (s *stream[T]) AnyMatch(predicate func(T) bool) bool {
 
 // All checks if all elements satisfy the predicate.
 func (s *stream[T]) All(predicate func(T) bool) bool {
-	iterator := s.sourceFactory() // Fresh iterator
+	iterator, sc := s.open() // Fresh iterator
+	defer sc.close()
 	for {
 		value, ok := iterator()
 		if !ok {
@@ -94,7 +177,8 @@ func (s *stream[T]) All(predicate func(T) bool) bool {
 
 // ForEach executes an action for each element in Stream.
 func (s *stream[T]) ForEach(action func(T)) {
-	iterator := s.sourceFactory() // Fresh iterator
+	iterator, sc := s.open() // Fresh iterator
+	defer sc.close()
 	for {
 		value, ok := iterator()
 		if !ok {
@@ -120,7 +204,8 @@ func (s *stream[T]) Chunk(size int) [][]T {
 		return nil
 	}
 
-	iterator := s.sourceFactory() // Fresh iterator
+	iterator, sc := s.open() // Fresh iterator
+	defer sc.close()
 	var result [][]T
 	// OPTIMIZATION: preallocate if size known
 	if s.size != -1 {
@@ -152,8 +237,20 @@ func (s *stream[T]) Chunk(size int) [][]T {
 }
 
 // Last returns the last element and true, or zero value and false if Stream is empty.
+//
+// OPTIMIZATION: If size is known and it's 0, returns immediately without iteration.
+// If the Stream is indexable (slices, Range), the last element is read in O(1).
 func (s *stream[T]) Last() (T, bool) {
-	iterator := s.sourceFactory() // Fresh iterator
+	if s.size == 0 {
+		var zero T
+		return zero, false
+	}
+	if s.at != nil {
+		return s.at(s.size - 1), true
+	}
+
+	iterator, sc := s.open() // Fresh iterator
+	defer sc.close()
 	var last T
 	var found bool
 
@@ -169,10 +266,41 @@ func (s *stream[T]) Last() (T, bool) {
 	return last, found
 }
 
+// LastWhere returns the last element satisfying the predicate and true,
+// or zero value and false if no element satisfies it.
+func (s *stream[T]) LastWhere(predicate func(T) bool) (T, bool) {
+	iterator, sc := s.open() // Fresh iterator
+	defer sc.close()
+	var last T
+	var found bool
+
+	for {
+		value, ok := iterator()
+		if !ok {
+			break
+		}
+		if predicate(value) {
+			last = value
+			found = true
+		}
+	}
+
+	return last, found
+}
+
+// LastOrDefault returns the last element, or defaultValue if Stream is empty.
+func (s *stream[T]) LastOrDefault(defaultValue T) T {
+	if value, ok := s.Last(); ok {
+		return value
+	}
+	return defaultValue
+}
+
 // ElementAt returns the element at the specified index and true, or zero value and false if index is out of range.
 // Index is zero-based. Negative indices are treated as out of range.
 //
 // OPTIMIZATION: If size is known and index is out of range, returns immediately without iteration.
+// If the Stream is indexable (slices, Range), the element is read in O(1).
 func (s *stream[T]) ElementAt(index int) (T, bool) {
 	if index < 0 {
 		var zero T
@@ -187,7 +315,12 @@ func (s *stream[T]) ElementAt(index int) (T, bool) {
 		}
 	}
 
-	iterator := s.sourceFactory() // Fresh iterator
+	if s.at != nil {
+		return s.at(index), true
+	}
+
+	iterator, sc := s.open() // Fresh iterator
+	defer sc.close()
 	currentIndex := 0
 
 	for {
@@ -207,6 +340,7 @@ func (s *stream[T]) ElementAt(index int) (T, bool) {
 // Index is zero-based. Negative indices return the default value.
 //
 // OPTIMIZATION: If size is known and index is out of range, returns default immediately without iteration.
+// If the Stream is indexable (slices, Range), the element is read in O(1).
 func (s *stream[T]) ElementAtOrDefault(index int, defaultValue T) T {
 	if index < 0 {
 		return defaultValue
@@ -219,7 +353,12 @@ func (s *stream[T]) ElementAtOrDefault(index int, defaultValue T) T {
 		}
 	}
 
-	iterator := s.sourceFactory() // Fresh iterator
+	if s.at != nil {
+		return s.at(index)
+	}
+
+	iterator, sc := s.open() // Fresh iterator
+	defer sc.close()
 	currentIndex := 0
 
 	for {
@@ -236,6 +375,8 @@ func (s *stream[T]) ElementAtOrDefault(index int, defaultValue T) T {
 
 // Contains checks if the Stream contains the specified element.
 // Uses reflect.DeepEqual for comparison, which works with all types including non-comparable ones.
+// Booleans, numbers and strings are compared with == without reflection (see DeepEqualComparer).
+// Use ContainsWith for custom equality.
 //
 // OPTIMIZATION: If size is known and it's 0, returns false immediately.
 //
@@ -250,18 +391,7 @@ func (s *stream[T]) Contains(value T) bool {
 		return false
 	}
 
-	iterator := s.sourceFactory() // Fresh iterator
-	for {
-		val, ok := iterator()
-		if !ok {
-			break
-		}
-		// Use reflect.DeepEqual for comparison - works with all types
-		if reflect.DeepEqual(val, value) {
-			return true
-		}
-	}
-	return false
+	return ContainsWith[T](s, value, DeepEqualComparer[T]())
 }
 
 // ContainsBy checks if the Stream contains an element matching the specified key.
@@ -282,19 +412,59 @@ func (s *stream[T]) ContainsBy(targetKey any, keySelector func(T) any) bool {
 		return false
 	}
 
-	iterator := s.sourceFactory() // Fresh iterator
+	return ContainsWith(Select[T, any](s, keySelector), targetKey, DeepEqualComparer[any]())
+}
+
+// ContainsKey checks if the Enumerable contains an element whose key equals targetKey.
+// Unlike the ContainsBy method, the key type is checked at compile time and keys are
+// compared with == instead of reflect.DeepEqual.
+// This is a function (not a method) because methods cannot have their own type parameters.
+//
+// Example:
+//
+//	type Person struct { ID int; Name string }
+//	hasID1 := ContainsKey(From(people), 1, func(p Person) int { return p.ID })
+func ContainsKey[T any, K comparable](enum Enumerable[T], targetKey K, keySelector func(T) K) bool {
+	if sizeOf(enum) == 0 {
+		return false
+	}
+
+	iterator, sc := openEnumerable(enum) // Fresh iterator if possible
+	defer sc.close()
 	for {
 		val, ok := iterator()
 		if !ok {
-			break
+			return false
 		}
-		key := keySelector(val)
-		// Use reflect.DeepEqual for key comparison - works with all types
-		if reflect.DeepEqual(key, targetKey) {
+		if keySelector(val) == targetKey {
+			return true
+		}
+	}
+}
+
+// ContainsWith checks if the Enumerable contains an element equal to value under comparer.
+// This is a function (not a method) because in Go methods cannot have their own type parameters.
+//
+// Example:
+//
+//	hasGo := ContainsWith(From([]string{"Rust", "GO"}), "go", StringFoldComparer())
+//	// hasGo = true
+func ContainsWith[T any](enum Enumerable[T], value T, comparer EqualityComparer[T]) bool {
+	if sizeOf(enum) == 0 {
+		return false
+	}
+
+	iterator, sc := openEnumerable(enum) // Fresh iterator if possible
+	defer sc.close()
+	for {
+		val, ok := iterator()
+		if !ok {
+			return false
+		}
+		if comparer.Equals(val, value) {
 			return true
 		}
 	}
-	return false
 }
 
 // Min returns the minimum element using comparator function.
@@ -310,7 +480,8 @@ func (s *stream[T]) ContainsBy(targetKey any, keySelector func(T) any) bool {
 //	})
 //	// youngest = Person{Age: 25, Name: "Bob"}, ok = true
 func (s *stream[T]) Min(comparator func(T, T) int) (T, bool) {
-	iterator := s.sourceFactory() // Fresh iterator
+	iterator, sc := s.open() // Fresh iterator
+	defer sc.close()
 	var minVal T
 	var found bool
 
@@ -341,7 +512,8 @@ func (s *stream[T]) Min(comparator func(T, T) int) (T, bool) {
 //	})
 //	// oldest = Person{Age: 30, Name: "Alice"}, ok = true
 func (s *stream[T]) Max(comparator func(T, T) int) (T, bool) {
-	iterator := s.sourceFactory() // Fresh iterator
+	iterator, sc := s.open() // Fresh iterator
+	defer sc.close()
 	var maxVal T
 	var found bool
 
@@ -374,7 +546,31 @@ func (s *stream[T]) Max(comparator func(T, T) int) (T, bool) {
 //	product := From(numbers).Aggregate(1, func(acc, x int) int { return acc * x })
 //	// 6
 func (s *stream[T]) Aggregate(seed T, accumulator func(T, T) T) T {
-	iterator := s.sourceFactory() // Fresh iterator
+	iterator, sc := s.open() // Fresh iterator
+	defer sc.close()
+	result := seed
+	for {
+		value, ok := iterator()
+		if !ok {
+			break
+		}
+		result = accumulator(result, value)
+	}
+	return result
+}
+
+// Fold applies an accumulator function over the Enumerable, starting from seed.
+// Unlike the Aggregate method, the accumulator type A may differ from the element type T.
+// This is a function (not a method) because in Go methods cannot have their own type parameters.
+//
+// Example:
+//
+//	words := []string{"go", "is", "fun"}
+//	totalLen := Fold(From(words), 0, func(acc int, w string) int { return acc + len(w) })
+//	// 7
+func Fold[T, A any](enum Enumerable[T], seed A, accumulator func(A, T) A) A {
+	iterator, sc := openEnumerable(enum) // Fresh iterator if possible
+	defer sc.close()
 	result := seed
 	for {
 		value, ok := iterator()
@@ -386,7 +582,31 @@ func (s *stream[T]) Aggregate(seed T, accumulator func(T, T) T) T {
 	return result
 }
 
+// AggregateWithResult folds the Enumerable like Fold and then applies resultSelector
+// to the final accumulator value.
+// This is a function (not a method) because in Go methods cannot have their own type parameters.
+//
+// Example:
+//
+//	type acc struct{ sum, n int }
+//	avg := AggregateWithResult(
+//	    From([]int{1, 2, 3, 4}),
+//	    acc{},
+//	    func(a acc, x int) acc { return acc{a.sum + x, a.n + 1} },
+//	    func(a acc) float64 { return float64(a.sum) / float64(a.n) },
+//	)
+//	// 2.5
+func AggregateWithResult[T, A, R any](
+	enum Enumerable[T],
+	seed A,
+	accumulator func(A, T) A,
+	resultSelector func(A) R,
+) R {
+	return resultSelector(Fold(enum, seed, accumulator))
+}
+
 // ToMapBy materializes Enumerable[T] into a map using selectors for key and value.
+// For duplicate keys the last value wins (see ToMapWith for other policies).
 //
 // Example:
 //
@@ -403,9 +623,11 @@ func ToMapBy[T any, K comparable, V any](
 	keySelector func(T) K,
 	valueSelector func(T) V,
 ) map[K]V {
+	iterator, sc := openEnumerable(enum) // Fresh iterator if possible
+	defer sc.close()
 	result := make(map[K]V)
 	for {
-		item, ok := enum.Next()
+		item, ok := iterator()
 		if !ok {
 			break
 		}
@@ -415,3 +637,23 @@ func ToMapBy[T any, K comparable, V any](
 	}
 	return result
 }
+
+// ToSet materializes the distinct elements of Enumerable[T] into a set.
+//
+// Example:
+//
+//	seen := ToSet(From([]string{"a", "b", "a"}))
+//	if _, ok := seen["a"]; ok { ... }
+//	// map[string]struct{}{"a": {}, "b": {}}
+func ToSet[T comparable](enum Enumerable[T]) map[T]struct{} {
+	iterator, sc := openEnumerable(enum) // Fresh iterator if possible
+	defer sc.close()
+	result := make(map[T]struct{})
+	for {
+		item, ok := iterator()
+		if !ok {
+			return result
+		}
+		result[item] = struct{}{}
+	}
+}
@@ -1,6 +1,7 @@
 package glinq
 
 import (
+	"errors"
 	"testing"
 	"time"
 )
@@ -568,3 +569,175 @@ func TestContainsBy(t *testing.T) {
 		}
 	})
 }
+
+func TestContainsKey(t *testing.T) {
+	type Person struct {
+		ID   int
+		Name string
+	}
+	people := From([]Person{{1, "Alice"}, {2, "Bob"}})
+
+	if !ContainsKey(people, 2, func(p Person) int { return p.ID }) {
+		t.Error("Expected to find ID 2")
+	}
+	if ContainsKey(people, 3, func(p Person) int { return p.ID }) {
+		t.Error("Expected not to find ID 3")
+	}
+	if !ContainsKey(people, "Alice", func(p Person) string { return p.Name }) {
+		t.Error("Expected to find name Alice")
+	}
+	if ContainsKey(Empty[Person](), 1, func(p Person) int { return p.ID }) {
+		t.Error("Expected false for empty stream")
+	}
+}
+
+func TestFold(t *testing.T) {
+	words := From([]string{"go", "is", "fun"})
+
+	totalLen := Fold(words, 0, func(acc int, w string) int { return acc + len(w) })
+	if totalLen != 7 {
+		t.Errorf("Expected 7, got %d", totalLen)
+	}
+
+	joined := Fold(words, "", func(acc string, w string) string { return acc + w })
+	if joined != "goisfun" {
+		t.Errorf("Expected goisfun, got %s", joined)
+	}
+
+	if seed := Fold(Empty[int](), 42, func(acc, x int) int { return acc + x }); seed != 42 {
+		t.Errorf("Expected seed 42 for empty stream, got %d", seed)
+	}
+}
+
+func TestAggregateWithResult(t *testing.T) {
+	type acc struct{ sum, n int }
+
+	avg := AggregateWithResult(
+		From([]int{1, 2, 3, 4}),
+		acc{},
+		func(a acc, x int) acc { return acc{a.sum + x, a.n + 1} },
+		func(a acc) float64 { return float64(a.sum) / float64(a.n) },
+	)
+	if avg != 2.5 {
+		t.Errorf("Expected 2.5, got %v", avg)
+	}
+}
+
+func TestSingle(t *testing.T) {
+	t.Run("Exactly one element", func(t *testing.T) {
+		value, err := From([]int{42}).Single()
+		if err != nil || value != 42 {
+			t.Errorf("Expected 42, nil, got %d, %v", value, err)
+		}
+	})
+
+	t.Run("No elements", func(t *testing.T) {
+		_, err := Empty[int]().Single()
+		if !errors.Is(err, ErrNoElements) {
+			t.Errorf("Expected ErrNoElements, got %v", err)
+		}
+		_, err = From([]int{1, 2}).Where(func(x int) bool { return x > 5 }).Single()
+		if !errors.Is(err, ErrNoElements) {
+			t.Errorf("Expected ErrNoElements, got %v", err)
+		}
+	})
+
+	t.Run("More than one element", func(t *testing.T) {
+		pulled := 0
+		s := Range(0, 100).Select(func(x int) int {
+			pulled++
+			return x
+		})
+		_, err := s.Single()
+		if !errors.Is(err, ErrMoreThanOneElement) {
+			t.Errorf("Expected ErrMoreThanOneElement, got %v", err)
+		}
+		if pulled != 2 {
+			t.Errorf("Expected Single to stop after 2 elements, pulled %d", pulled)
+		}
+	})
+
+	t.Run("Take over filtered source", func(t *testing.T) {
+		value, err := Range(0, 10).Where(func(x int) bool { return x == 7 }).Take(3).Single()
+		if err != nil || value != 7 {
+			t.Errorf("Expected 7, nil, got %d, %v", value, err)
+		}
+	})
+}
+
+func TestSingleOrDefault(t *testing.T) {
+	if value, err := Empty[int]().SingleOrDefault(-1); err != nil || value != -1 {
+		t.Errorf("Expected -1, nil, got %d, %v", value, err)
+	}
+	if value, err := From([]int{5}).SingleOrDefault(-1); err != nil || value != 5 {
+		t.Errorf("Expected 5, nil, got %d, %v", value, err)
+	}
+	if _, err := From([]int{5, 6}).SingleOrDefault(-1); !errors.Is(err, ErrMoreThanOneElement) {
+		t.Errorf("Expected ErrMoreThanOneElement, got %v", err)
+	}
+}
+
+func TestFirstWhereLastWhere(t *testing.T) {
+	numbers := From([]int{1, 4, 6, 7, 8, 9})
+	isEven := func(x int) bool { return x%2 == 0 }
+
+	if value, ok := numbers.FirstWhere(isEven); !ok || value != 4 {
+		t.Errorf("FirstWhere: expected 4, got %d (ok=%v)", value, ok)
+	}
+	if value, ok := numbers.LastWhere(isEven); !ok || value != 8 {
+		t.Errorf("LastWhere: expected 8, got %d (ok=%v)", value, ok)
+	}
+
+	negative := func(x int) bool { return x < 0 }
+	if _, ok := numbers.FirstWhere(negative); ok {
+		t.Error("FirstWhere: expected false when nothing matches")
+	}
+	if _, ok := numbers.LastWhere(negative); ok {
+		t.Error("LastWhere: expected false when nothing matches")
+	}
+}
+
+func TestFirstOrDefaultLastOrDefault(t *testing.T) {
+	numbers := From([]int{3, 5, 7})
+
+	if value := numbers.FirstOrDefault(-1); value != 3 {
+		t.Errorf("FirstOrDefault: expected 3, got %d", value)
+	}
+	if value := numbers.LastOrDefault(-1); value != 7 {
+		t.Errorf("LastOrDefault: expected 7, got %d", value)
+	}
+	if value := Empty[int]().FirstOrDefault(-1); value != -1 {
+		t.Errorf("FirstOrDefault: expected -1, got %d", value)
+	}
+	if value := Empty[int]().LastOrDefault(-1); value != -1 {
+		t.Errorf("LastOrDefault: expected -1, got %d", value)
+	}
+}
+
+func TestContainsWith(t *testing.T) {
+	if !ContainsWith(From([]string{"Rust", "GO"}), "go", StringFoldComparer()) {
+		t.Error("Expected case-insensitive match")
+	}
+	if ContainsWith(From([]float64{1.0, 2.0}), 1.5, FloatComparer(0.1)) {
+		t.Error("Expected no match outside epsilon")
+	}
+	if ContainsWith(Empty[string](), "", StringFoldComparer()) {
+		t.Error("Expected false for empty stream")
+	}
+}
+
+func TestToSet(t *testing.T) {
+	result := ToSet(From([]string{"a", "b", "a", "c", "b"}))
+	if len(result) != 3 {
+		t.Errorf("Expected 3 elements, got %v", result)
+	}
+	for _, key := range []string{"a", "b", "c"} {
+		if _, ok := result[key]; !ok {
+			t.Errorf("Expected %q in set", key)
+		}
+	}
+
+	if empty := ToSet(Empty[int]()); empty == nil || len(empty) != 0 {
+		t.Errorf("Expected empty non-nil set, got %v", empty)
+	}
+}
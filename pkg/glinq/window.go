@@ -0,0 +1,222 @@
+package glinq
+
+// Chunked lazily splits the elements into chunks of the specified size.
+// The last chunk may contain fewer elements than size.
+// Unlike the Chunk terminal, only one chunk is held in memory at a time.
+// This is a function (not a method) because in Go methods cannot have their own type parameters.
+//
+// SIZE: Calculated as ceil(sourceSize / size) if source size known, else unknown.
+// If size is negative or zero, returns an empty Stream.
+//
+// Example:
+//
+//	batches := Chunked(From([]int{1, 2, 3, 4, 5}), 2).ToSlice()
+//	// [][]int{{1, 2}, {3, 4}, {5}}
+func Chunked[T any](enum Enumerable[T], size int) Stream[[]T] {
+	if size <= 0 {
+		return Empty[[]T]()
+	}
+
+	var newSize = -1
+	if s := sizeOf(enum); s != -1 {
+		newSize = (s + size - 1) / size // ceil division
+	}
+
+	return &stream[[]T]{
+		sourceFactory: func(sc *scope) func() ([]T, bool) {
+			source := iteratorOf(enum, sc) // Get fresh source
+			return func() ([]T, bool) {
+				var chunk []T
+				for len(chunk) < size {
+					value, ok := source()
+					if !ok {
+						break
+					}
+					chunk = append(chunk, value)
+				}
+				if len(chunk) == 0 {
+					return nil, false
+				}
+				return chunk, true
+			}
+		},
+		size:    newSize,
+		oneShot: isOneShot(enum),
+	}
+}
+
+// Window lazily produces sliding windows of the specified size, advancing by step elements.
+// Only full windows are emitted; each window is a new slice that may be retained by the caller.
+// With step == size the windows are tumbling (non-overlapping); with step > size elements
+// between windows are skipped.
+// This is a function (not a method) because in Go methods cannot have their own type parameters.
+//
+// SIZE: Calculated as (sourceSize - size) / step + 1 if source size known (0 if shorter than size),
+// else unknown. If size or step is negative or zero, returns an empty Stream.
+//
+// Example:
+//
+//	moving := Select(
+//	    Window(From([]float64{1, 2, 3, 4, 5}), 3, 1),
+//	    func(w []float64) float64 { avg, _ := Average(From(w)); return avg },
+//	).ToSlice()
+//	// [2, 3, 4]
+//
+//nolint:gocognit
+func Window[T any](enum Enumerable[T], size, step int) Stream[[]T] {
+	if size <= 0 || step <= 0 {
+		return Empty[[]T]()
+	}
+
+	var newSize = -1
+	if s := sizeOf(enum); s != -1 {
+		newSize = 0
+		if s >= size {
+			newSize = (s-size)/step + 1
+		}
+	}
+
+	return &stream[[]T]{
+		sourceFactory: func(sc *scope) func() ([]T, bool) {
+			source := iteratorOf(enum, sc) // Get fresh source
+			buffer := make([]T, 0, size)
+			skip := 0 // Elements to drop before the next window when step > size
+
+			return func() ([]T, bool) {
+				for ; skip > 0; skip-- {
+					if _, ok := source(); !ok {
+						return nil, false
+					}
+				}
+				for len(buffer) < size {
+					value, ok := source()
+					if !ok {
+						return nil, false
+					}
+					buffer = append(buffer, value)
+				}
+
+				window := make([]T, size)
+				copy(window, buffer)
+
+				// Advance the buffer by step
+				if step < size {
+					buffer = append(buffer[:0], buffer[step:]...)
+				} else {
+					buffer = buffer[:0]
+					skip = step - size
+				}
+				return window, true
+			}
+		},
+		size:    newSize,
+		oneShot: isOneShot(enum),
+	}
+}
+
+// Pairwise lazily produces every pair of adjacent elements.
+// For elements a, b, c the result is (a, b), (b, c).
+// This is a function (not a method) because in Go methods cannot have their own type parameters.
+//
+// SIZE: Calculated as max(0, sourceSize - 1) if source size known, else unknown.
+//
+// Example:
+//
+//	deltas := Select(
+//	    Pairwise(From([]int{1, 4, 9, 16})),
+//	    func(p Pair[int, int]) int { return p.Second - p.First },
+//	).ToSlice()
+//	// [3, 5, 7]
+func Pairwise[T any](enum Enumerable[T]) Stream[Pair[T, T]] {
+	var newSize = -1
+	if s := sizeOf(enum); s != -1 {
+		newSize = 0
+		if s > 1 {
+			newSize = s - 1
+		}
+	}
+
+	return &stream[Pair[T, T]]{
+		sourceFactory: func(sc *scope) func() (Pair[T, T], bool) {
+			source := iteratorOf(enum, sc) // Get fresh source
+			var previous T
+			started := false
+
+			return func() (Pair[T, T], bool) {
+				if !started {
+					value, ok := source()
+					if !ok {
+						return Pair[T, T]{}, false
+					}
+					previous = value
+					started = true
+				}
+				current, ok := source()
+				if !ok {
+					return Pair[T, T]{}, false
+				}
+				pair := Pair[T, T]{First: previous, Second: current}
+				previous = current
+				return pair, true
+			}
+		},
+		size:    newSize,
+		oneShot: isOneShot(enum),
+	}
+}
+
+// ChunkBy lazily groups consecutive elements with equal keys into runs.
+// Unlike GroupBy, a key that reappears later starts a new run.
+// This is a function (not a method) because in Go methods cannot have their own type parameters.
+//
+// SIZE: Loses size (unknown number of runs).
+//
+// Example:
+//
+//	runs := ChunkBy(From([]int{1, 1, 2, 2, 2, 1}), func(x int) int { return x }).ToSlice()
+//	// [][]int{{1, 1}, {2, 2, 2}, {1}}
+//
+//nolint:gocognit
+func ChunkBy[T any, K comparable](enum Enumerable[T], keySelector func(T) K) Stream[[]T] {
+	return &stream[[]T]{
+		sourceFactory: func(sc *scope) func() ([]T, bool) {
+			source := iteratorOf(enum, sc) // Get fresh source
+			var pending T                  // First element of the next run
+			var pendingKey K
+			hasPending := false
+			started := false
+
+			return func() ([]T, bool) {
+				if !started {
+					started = true
+					value, ok := source()
+					if ok {
+						pending, pendingKey, hasPending = value, keySelector(value), true
+					}
+				}
+				if !hasPending {
+					return nil, false
+				}
+
+				run := []T{pending}
+				runKey := pendingKey
+				hasPending = false
+				for {
+					value, ok := source()
+					if !ok {
+						break
+					}
+					key := keySelector(value)
+					if key != runKey {
+						pending, pendingKey, hasPending = value, key, true
+						break
+					}
+					run = append(run, value)
+				}
+				return run, true
+			}
+		},
+		size:    -1, // LOSE: unknown number of runs
+		oneShot: isOneShot(enum),
+	}
+}
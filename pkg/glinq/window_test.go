@@ -0,0 +1,107 @@
+package glinq
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestChunked(t *testing.T) {
+	s := Chunked(From([]int{1, 2, 3, 4, 5}), 2)
+	assertSize(t, s, 3, "Chunked")
+	assertReiterable(t, "Chunked", s, [][]int{{1, 2}, {3, 4}, {5}})
+
+	t.Run("Matches Chunk terminal", func(t *testing.T) {
+		source := Range(0, 10)
+		if !reflect.DeepEqual(Chunked(source, 3).ToSlice(), source.Chunk(3)) {
+			t.Error("Chunked and Chunk disagree")
+		}
+	})
+
+	t.Run("Lazy over infinite source", func(t *testing.T) {
+		result := Chunked(Iterate(1, func(x int) int { return x + 1 }), 2).Take(2).ToSlice()
+		if expected := [][]int{{1, 2}, {3, 4}}; !reflect.DeepEqual(result, expected) {
+			t.Errorf("Expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("Invalid size", func(t *testing.T) {
+		if Chunked(From([]int{1}), 0).Any() {
+			t.Error("Expected empty stream for size 0")
+		}
+	})
+}
+
+func TestWindow(t *testing.T) {
+	t.Run("Sliding", func(t *testing.T) {
+		s := Window(From([]int{1, 2, 3, 4, 5}), 3, 1)
+		assertSize(t, s, 3, "Window")
+		assertReiterable(t, "Window", s, [][]int{{1, 2, 3}, {2, 3, 4}, {3, 4, 5}})
+	})
+
+	t.Run("Step two", func(t *testing.T) {
+		s := Window(Range(1, 6), 3, 2)
+		assertSize(t, s, 2, "Window step 2")
+		assertReiterable(t, "Window step 2", s, [][]int{{1, 2, 3}, {3, 4, 5}})
+	})
+
+	t.Run("Tumbling", func(t *testing.T) {
+		s := Window(Range(1, 7), 3, 3)
+		assertSize(t, s, 2, "Window tumbling")
+		assertReiterable(t, "Window tumbling", s, [][]int{{1, 2, 3}, {4, 5, 6}})
+	})
+
+	t.Run("Step larger than size skips elements", func(t *testing.T) {
+		s := Window(Range(1, 8), 2, 3)
+		assertSize(t, s, 3, "Window skipping")
+		assertReiterable(t, "Window skipping", s, [][]int{{1, 2}, {4, 5}, {7, 8}})
+	})
+
+	t.Run("Source shorter than window", func(t *testing.T) {
+		s := Window(From([]int{1, 2}), 3, 1)
+		assertSize(t, s, 0, "Window short")
+		if s.Any() || len(s.ToSlice()) != 0 {
+			t.Error("Expected no windows")
+		}
+	})
+
+	t.Run("Windows are independent slices", func(t *testing.T) {
+		windows := Window(Range(1, 4), 2, 1).ToSlice()
+		windows[0][1] = 99
+		if windows[1][0] != 2 {
+			t.Errorf("Expected windows not to share memory, got %v", windows)
+		}
+	})
+
+	t.Run("Invalid arguments", func(t *testing.T) {
+		if Window(Range(1, 4), 0, 1).Any() || Window(Range(1, 4), 2, 0).Any() {
+			t.Error("Expected empty stream for invalid size or step")
+		}
+	})
+}
+
+func TestPairwise(t *testing.T) {
+	s := Pairwise(From([]int{1, 4, 9, 16}))
+	assertSize(t, s, 3, "Pairwise")
+	assertReiterable(t, "Pairwise", s, []Pair[int, int]{{1, 4}, {4, 9}, {9, 16}})
+
+	assertSize(t, Pairwise(From([]int{1})), 0, "Pairwise single")
+	if Pairwise(From([]int{1})).Any() || Pairwise(Empty[int]()).Any() {
+		t.Error("Expected no pairs for fewer than two elements")
+	}
+}
+
+func TestChunkBy(t *testing.T) {
+	s := ChunkBy(From([]int{1, 1, 2, 2, 2, 1}), func(x int) int { return x })
+	assertReiterable(t, "ChunkBy", s, [][]int{{1, 1}, {2, 2, 2}, {1}})
+
+	words := From([]string{"apple", "avocado", "banana", "blueberry", "apricot"})
+	runs := ChunkBy(words, func(s string) byte { return s[0] }).ToSlice()
+	expected := [][]string{{"apple", "avocado"}, {"banana", "blueberry"}, {"apricot"}}
+	if !reflect.DeepEqual(runs, expected) {
+		t.Errorf("Expected %v, got %v", expected, runs)
+	}
+
+	if ChunkBy(Empty[int](), func(x int) int { return x }).Any() {
+		t.Error("Expected no runs for empty source")
+	}
+}
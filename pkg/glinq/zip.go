@@ -0,0 +1,314 @@
+package glinq
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+)
+
+// Zip3 combines three Enumerables by applying a result selector function to corresponding elements.
+// The result stream stops when any source is exhausted.
+// This is a function (not a method) because methods cannot have their own type parameters.
+//
+// NOTE: As with Zip, when the sizes are unknown the sources before the exhausted one have
+// already been pulled for the incomplete row, and those elements are lost.
+//
+// SIZE: Calculated as the minimum of the source sizes if all are known, else unknown.
+//
+// Example:
+//
+//	rows := Zip3(
+//	    From(names), From(ages), From(cities),
+//	    func(n string, a int, c string) string { return fmt.Sprintf("%s (%d), %s", n, a, c) },
+//	).ToSlice()
+func Zip3[T1, T2, T3, R any](
+	e1 Enumerable[T1],
+	e2 Enumerable[T2],
+	e3 Enumerable[T3],
+	resultSelector func(T1, T2, T3) R,
+) Stream[R] {
+	limit, size := zipBounds(sizeOf(e1), sizeOf(e2), sizeOf(e3))
+
+	return &stream[R]{
+		sourceFactory: func(sc *scope) func() (R, bool) {
+			source1 := iteratorOf(e1, sc) // Get fresh sources
+			source2 := iteratorOf(e2, sc)
+			source3 := iteratorOf(e3, sc)
+			count := 0
+			return func() (R, bool) {
+				var zero R
+				if limit != -1 && count >= limit {
+					return zero, false // Don't pull an element that can't be combined
+				}
+
+				val1, ok := source1()
+				if !ok {
+					return zero, false
+				}
+				val2, ok := source2()
+				if !ok {
+					return zero, false
+				}
+				val3, ok := source3()
+				if !ok {
+					return zero, false
+				}
+
+				count++
+				return resultSelector(val1, val2, val3), true
+			}
+		},
+		size:    size, // CALCULATED: min of sizes if all known
+		oneShot: isOneShot(e1) || isOneShot(e2) || isOneShot(e3),
+	}
+}
+
+// ZipN combines any number of Enumerables of the same type into a Stream of rows,
+// where row i holds the i-th element of every source, in source order.
+// Each row is a new slice. The result stream stops when any source is exhausted.
+// With no sources the result is empty.
+// This is a function (not a method) because methods cannot have their own type parameters.
+//
+// NOTE: As with Zip, when the sizes are unknown the sources before the exhausted one have
+// already been pulled for the incomplete row, and those elements are lost.
+//
+// SIZE: Calculated as the minimum of the source sizes if all are known, else unknown.
+//
+// Example:
+//
+//	columns := []Enumerable[int]{From([]int{1, 2}), From([]int{3, 4}), From([]int{5, 6})}
+//	rows := ZipN(columns).ToSlice()
+//	// [[1, 3, 5], [2, 4, 6]]
+func ZipN[T any](enums []Enumerable[T]) Stream[[]T] {
+	if len(enums) == 0 {
+		return Empty[[]T]()
+	}
+
+	sizes := make([]int, len(enums))
+	for i, enum := range enums {
+		sizes[i] = sizeOf(enum)
+	}
+	limit, size := zipBounds(sizes...)
+
+	return &stream[[]T]{
+		sourceFactory: func(sc *scope) func() ([]T, bool) {
+			sources := make([]func() (T, bool), len(enums))
+			for i, enum := range enums {
+				sources[i] = iteratorOf(enum, sc) // Get fresh sources
+			}
+			count := 0
+			return func() ([]T, bool) {
+				if limit != -1 && count >= limit {
+					return nil, false // Don't pull elements that can't be combined
+				}
+
+				row := make([]T, len(sources))
+				for i, source := range sources {
+					value, ok := source()
+					if !ok {
+						return nil, false
+					}
+					row[i] = value
+				}
+
+				count++
+				return row, true
+			}
+		},
+		size:    size, // CALCULATED: min of sizes if all known
+		oneShot: slices.ContainsFunc(enums, isOneShot[T]),
+	}
+}
+
+// ZipLongest combines two Enumerables element by element until both are exhausted.
+// Once the shorter source ends, resultSelector receives the zero value for it with the
+// corresponding has flag set to false. An exhausted source is not pulled again.
+// This is a function (not a method) because methods cannot have their own type parameters.
+//
+// SIZE: Calculated as max(e1Size, e2Size) if both sizes are known, else unknown.
+//
+// Example:
+//
+//	rows := ZipLongest(
+//	    From([]int{1, 2, 3}),
+//	    From([]string{"a"}),
+//	    func(n int, hasN bool, s string, hasS bool) string {
+//	        if !hasS {
+//	            s = "-"
+//	        }
+//	        return fmt.Sprintf("%d:%s", n, s)
+//	    },
+//	).ToSlice()
+//	// ["1:a", "2:-", "3:-"]
+func ZipLongest[T1, T2, R any](
+	e1 Enumerable[T1],
+	e2 Enumerable[T2],
+	resultSelector func(first T1, hasFirst bool, second T2, hasSecond bool) R,
+) Stream[R] {
+	size := -1
+	if s1, s2 := sizeOf(e1), sizeOf(e2); s1 != -1 && s2 != -1 {
+		size = max(s1, s2)
+	}
+
+	return &stream[R]{
+		sourceFactory: func(sc *scope) func() (R, bool) {
+			source1 := iteratorOf(e1, sc) // Get fresh sources
+			source2 := iteratorOf(e2, sc)
+			done1, done2 := false, false
+			return func() (R, bool) {
+				var val1 T1
+				var val2 T2
+				ok1, ok2 := false, false
+				if !done1 {
+					val1, ok1 = source1()
+					done1 = !ok1
+				}
+				if !done2 {
+					val2, ok2 = source2()
+					done2 = !ok2
+				}
+				if !ok1 && !ok2 {
+					var zero R
+					return zero, false
+				}
+				return resultSelector(val1, ok1, val2, ok2), true
+			}
+		},
+		size:    size, // CALCULATED: max(e1Size, e2Size) if both known
+		oneShot: isOneShot(e1) || isOneShot(e2),
+	}
+}
+
+// ZipLongestFill pairs the elements of two Enumerables until both are exhausted,
+// substituting fill1 or fill2 for the missing elements of the shorter source.
+// This is a function (not a method) because methods cannot have their own type parameters.
+//
+// SIZE: Calculated as max(e1Size, e2Size) if both sizes are known, else unknown.
+//
+// Example:
+//
+//	pairs := ZipLongestFill(From([]int{1, 2}), From([]string{"a"}), 0, "?").ToSlice()
+//	// [{1 a} {2 ?}]
+func ZipLongestFill[T1, T2 any](e1 Enumerable[T1], e2 Enumerable[T2], fill1 T1, fill2 T2) Stream[Pair[T1, T2]] {
+	return ZipLongest(e1, e2, func(first T1, hasFirst bool, second T2, hasSecond bool) Pair[T1, T2] {
+		if !hasFirst {
+			first = fill1
+		}
+		if !hasSecond {
+			second = fill2
+		}
+		return Pair[T1, T2]{First: first, Second: second}
+	})
+}
+
+// ZipExact combines two Enumerables that must have the same length.
+// If one source ends before the other, the terminal operation returns an error wrapping ErrLengthMismatch.
+// When both sizes are known and differ, the error is reported before any element is produced.
+// This is a function (not a method) because methods cannot have their own type parameters.
+//
+// Example:
+//
+//	pairs, err := ZipExact(From(keys), From(values), func(k string, v int) KeyValue[string, int] {
+//	    return KeyValue[string, int]{Key: k, Value: v}
+//	}).ToSlice()
+//	if errors.Is(err, ErrLengthMismatch) { ... }
+func ZipExact[T1, T2, R any](e1 Enumerable[T1], e2 Enumerable[T2], resultSelector func(T1, T2) R) ResultStream[R] {
+	return newFallible(context.Background(), func(sc *scope) func() (R, bool, error) {
+		var zero R
+		if s1, s2 := sizeOf(e1), sizeOf(e2); s1 != -1 && s2 != -1 && s1 != s2 {
+			err := fmt.Errorf("%w: %d and %d elements", ErrLengthMismatch, s1, s2)
+			return func() (R, bool, error) {
+				return zero, false, err
+			}
+		}
+
+		source1 := iteratorOf(e1, sc) // Get fresh sources
+		source2 := iteratorOf(e2, sc)
+		index := 0
+		return func() (R, bool, error) {
+			val1, ok1 := source1()
+			val2, ok2 := source2()
+			if ok1 != ok2 {
+				return zero, false, fmt.Errorf("%w: one sequence ended after %d elements", ErrLengthMismatch, index)
+			}
+			if !ok1 {
+				return zero, false, nil
+			}
+			index++
+			return resultSelector(val1, val2), true, nil
+		}
+	})
+}
+
+// Unzip splits a sequence of pairs into a Stream of first values and a Stream of second values.
+// If enum can start over (a Stream over a re-iterable source, or a Restartable Enumerable),
+// each Stream reads enum again on every iteration and sees its current elements.
+// Otherwise enum is read once, on the first use of either Stream, and buffered so both
+// Streams see every pair; the buffer is safe to share between goroutines.
+// This is a function (not a method) because methods cannot have their own type parameters.
+//
+// SIZE: Both Streams preserve size if enum is Sizable.
+//
+// Example:
+//
+//	names, ages := Unzip(Select(From(people), func(p Person) Pair[string, int] {
+//	    return Pair[string, int]{First: p.Name, Second: p.Age}
+//	}))
+func Unzip[A, B any](enum Enumerable[Pair[A, B]]) (Stream[A], Stream[B]) {
+	source := enum
+	if isOneShot(enum) {
+		source = bufferedOnce(enum)
+	}
+
+	first := Select(source, func(p Pair[A, B]) A { return p.First })
+	second := Select(source, func(p Pair[A, B]) B { return p.Second })
+	return first, second
+}
+
+// bufferedOnce returns a re-iterable Stream that reads enum to the end on its first iteration
+// and replays the buffered elements on every iteration, from any goroutine.
+func bufferedOnce[T any](enum Enumerable[T]) Stream[T] {
+	var items []T
+	var once sync.Once
+
+	return &stream[T]{
+		sourceFactory: func(sc *scope) func() (T, bool) {
+			return deferredIterator(func() []T {
+				once.Do(func() {
+					next := iteratorOf(enum, sc)
+					for {
+						value, ok := next()
+						if !ok {
+							break
+						}
+						items = append(items, value)
+					}
+				})
+				return items
+			})
+		},
+		size: sizeOf(enum),
+	}
+}
+
+// zipBounds returns the number of elements a zip of sources with the given sizes can produce
+// without pulling beyond the shortest known source (limit, -1 if no size is known),
+// and the exact result size (-1 unless every size is known).
+func zipBounds(sizes ...int) (limit, size int) {
+	limit = -1
+	allKnown := true
+	for _, s := range sizes {
+		if s == -1 {
+			allKnown = false
+			continue
+		}
+		if limit == -1 || s < limit {
+			limit = s
+		}
+	}
+	if allKnown {
+		return limit, limit
+	}
+	return limit, -1
+}
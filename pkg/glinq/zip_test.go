@@ -0,0 +1,216 @@
+package glinq
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func TestZipDoesNotLoseElements(t *testing.T) {
+	// e1 is shared and one-shot; e2 has a known size, so Zip must not pull a 3rd element from e1
+	shared := &oneShot{items: []int{1, 2, 3, 4}}
+	zipped := Zip(shared, From([]string{"a", "b"}), func(n int, s string) string {
+		return fmt.Sprintf("%d%s", n, s)
+	}).ToSlice()
+
+	if !reflect.DeepEqual(zipped, []string{"1a", "2b"}) {
+		t.Errorf("Expected [1a 2b], got %v", zipped)
+	}
+	if rest := FromEnumerable[int](shared).ToSlice(); !reflect.DeepEqual(rest, []int{3, 4}) {
+		t.Errorf("Expected remaining [3 4], got %v", rest)
+	}
+}
+
+func TestZipUnknownSizesLoseOneElement(t *testing.T) {
+	// Both sizes are unknown: Zip pulls 2 from e1 before it sees that e2 is exhausted
+	shared := &oneShot{items: []int{1, 2, 3, 4}}
+	zipped := Zip(shared, &oneShot{items: []int{10}}, func(a, b int) int { return a + b }).ToSlice()
+
+	if !reflect.DeepEqual(zipped, []int{11}) {
+		t.Errorf("Expected [11], got %v", zipped)
+	}
+	if rest := FromEnumerable[int](shared).ToSlice(); !reflect.DeepEqual(rest, []int{3, 4}) {
+		t.Errorf("Expected remaining [3 4] (2 is lost), got %v", rest)
+	}
+}
+
+func TestZip3(t *testing.T) {
+	result := Zip3(
+		From([]string{"a", "b", "c"}),
+		Range(1, 10),
+		From([]bool{true, false}),
+		func(s string, n int, b bool) string { return fmt.Sprintf("%s%d%v", s, n, b) },
+	)
+
+	assertReiterable(t, "Zip3", result, []string{"a1true", "b2false"})
+	assertSize(t, result, 2, "Zip3")
+}
+
+func TestZipN(t *testing.T) {
+	t.Run("Rows", func(t *testing.T) {
+		result := ZipN([]Enumerable[int]{From([]int{1, 2, 3}), From([]int{4, 5}), Range(7, 3)})
+		assertReiterable(t, "ZipN", result, [][]int{{1, 4, 7}, {2, 5, 8}})
+		assertSize(t, result, 2, "ZipN")
+	})
+
+	t.Run("Unknown size", func(t *testing.T) {
+		evens := From([]int{0, 1, 2, 3}).Where(func(x int) bool { return x%2 == 0 })
+		result := ZipN([]Enumerable[int]{evens, Range(10, 5)})
+		assertReiterable(t, "ZipN", result, [][]int{{0, 10}, {2, 11}})
+		if _, ok := result.Size(); ok {
+			t.Error("Expected unknown size")
+		}
+	})
+
+	t.Run("No sources", func(t *testing.T) {
+		if n := ZipN[int](nil).Count(); n != 0 {
+			t.Errorf("Expected empty result, got %d rows", n)
+		}
+	})
+}
+
+func TestZipLongest(t *testing.T) {
+	t.Run("Markers", func(t *testing.T) {
+		result := ZipLongest(From([]int{1, 2, 3}), From([]string{"a"}),
+			func(n int, hasN bool, s string, hasS bool) string {
+				return fmt.Sprintf("%d/%v:%q/%v", n, hasN, s, hasS)
+			})
+		assertReiterable(t, "ZipLongest", result, []string{`1/true:"a"/true`, `2/true:""/false`, `3/true:""/false`})
+		assertSize(t, result, 3, "ZipLongest")
+	})
+
+	t.Run("Fill values", func(t *testing.T) {
+		result := ZipLongestFill(From([]int{1}), From([]string{"a", "b"}), -1, "?")
+		expected := []Pair[int, string]{{1, "a"}, {-1, "b"}}
+		assertReiterable(t, "ZipLongestFill", result, expected)
+	})
+
+	t.Run("Exhausted source is not pulled again", func(t *testing.T) {
+		pulls := 0
+		short := FromSeq(func(yield func(int) bool) {
+			pulls++
+			yield(1)
+		})
+		n := ZipLongestFill[int, int](short, Range(0, 5), 0, 0).Count()
+		if n != 5 || pulls != 1 {
+			t.Errorf("Expected 5 pairs from one run of the short source, got %d pairs, %d runs", n, pulls)
+		}
+	})
+}
+
+func TestZipExact(t *testing.T) {
+	add := func(a, b int) int { return a + b }
+
+	t.Run("Equal lengths", func(t *testing.T) {
+		result, err := ZipExact(From([]int{1, 2}), From([]int{10, 20}), add).ToSlice()
+		if err != nil || !reflect.DeepEqual(result, []int{11, 22}) {
+			t.Errorf("Expected [11 22], nil, got %v, %v", result, err)
+		}
+	})
+
+	t.Run("Known sizes differ", func(t *testing.T) {
+		calls := 0
+		_, err := ZipExact(From([]int{1, 2}), From([]int{1}), func(a, b int) int {
+			calls++
+			return a + b
+		}).ToSlice()
+		if !errors.Is(err, ErrLengthMismatch) || calls != 0 {
+			t.Errorf("Expected ErrLengthMismatch before any element, got %v after %d calls", err, calls)
+		}
+	})
+
+	t.Run("Unknown sizes differ", func(t *testing.T) {
+		odd := From([]int{1, 2, 3}).Where(func(x int) bool { return x%2 == 1 })
+		count, err := ZipExact(odd, From([]int{1}), add).Count()
+		if !errors.Is(err, ErrLengthMismatch) {
+			t.Errorf("Expected ErrLengthMismatch, got %d, %v", count, err)
+		}
+	})
+}
+
+func TestUnzip(t *testing.T) {
+	pairs := []Pair[string, int]{{"a", 1}, {"b", 2}, {"c", 3}}
+
+	t.Run("Re-iterable source", func(t *testing.T) {
+		names, numbers := Unzip(From(pairs))
+		assertReiterable(t, "Unzip first", names, []string{"a", "b", "c"})
+		assertReiterable(t, "Unzip second", numbers, []int{1, 2, 3})
+		assertSize(t, names, 3, "Unzip")
+	})
+
+	t.Run("One-shot source", func(t *testing.T) {
+		names, numbers := Unzip[string, int](&pairShot{items: pairs})
+		assertReiterable(t, "Unzip second", numbers, []int{1, 2, 3})
+		assertReiterable(t, "Unzip first", names, []string{"a", "b", "c"})
+	})
+
+	t.Run("Re-iterable Stream sees source changes", func(t *testing.T) {
+		data := []Pair[string, int]{{"a", 1}, {"b", 2}, {"c", 3}}
+		names, numbers := Unzip(From(data).Where(func(p Pair[string, int]) bool { return p.Second < 3 }))
+		if result := numbers.ToSlice(); !reflect.DeepEqual(result, []int{1, 2}) {
+			t.Fatalf("Expected [1 2], got %v", result)
+		}
+		data[0] = Pair[string, int]{"z", 0}
+		if result := numbers.ToSlice(); !reflect.DeepEqual(result, []int{0, 2}) {
+			t.Errorf("Expected [0 2] after changing the source, got %v", result)
+		}
+		if result := names.ToSlice(); !reflect.DeepEqual(result, []string{"z", "b"}) {
+			t.Errorf("Expected [z b] after changing the source, got %v", result)
+		}
+	})
+
+	t.Run("Concurrent use", func(t *testing.T) {
+		sources := map[string]func() Enumerable[Pair[string, int]]{
+			"Re-iterable": func() Enumerable[Pair[string, int]] {
+				return From(pairs).Where(func(Pair[string, int]) bool { return true })
+			},
+			"One-shot": func() Enumerable[Pair[string, int]] { return &pairShot{items: pairs} },
+		}
+		for name, source := range sources {
+			names, numbers := Unzip(source())
+			var wg sync.WaitGroup
+			var gotNames []string
+			var gotNumbers []int
+			wg.Add(2)
+			go func() { defer wg.Done(); gotNames = names.ToSlice() }()
+			go func() { defer wg.Done(); gotNumbers = numbers.ToSlice() }()
+			wg.Wait()
+			if !reflect.DeepEqual(gotNames, []string{"a", "b", "c"}) || !reflect.DeepEqual(gotNumbers, []int{1, 2, 3}) {
+				t.Errorf("%s: expected [a b c] and [1 2 3], got %v and %v", name, gotNames, gotNumbers)
+			}
+		}
+	})
+
+	t.Run("FromChannel source", func(t *testing.T) {
+		ch := make(chan Pair[string, int], len(pairs))
+		for _, p := range pairs {
+			ch <- p
+		}
+		close(ch)
+		names, numbers := Unzip(FromChannel(ch))
+		assertReiterable(t, "Unzip first", names, []string{"a", "b", "c"})
+		assertReiterable(t, "Unzip second", numbers, []int{1, 2, 3})
+	})
+
+	t.Run("FromEnumerable over one-shot source", func(t *testing.T) {
+		names, numbers := Unzip(FromEnumerable[Pair[string, int]](&pairShot{items: pairs}))
+		assertReiterable(t, "Unzip second", numbers, []int{1, 2, 3})
+		assertReiterable(t, "Unzip first", names, []string{"a", "b", "c"})
+	})
+}
+
+// pairShot is a non-restartable Enumerable of pairs
+type pairShot struct {
+	items []Pair[string, int]
+	index int
+}
+
+func (p *pairShot) Next() (Pair[string, int], bool) {
+	if p.index >= len(p.items) {
+		return Pair[string, int]{}, false
+	}
+	p.index++
+	return p.items[p.index-1], true
+}